@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Service")
+func newServiceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &serviceDataSource{}, nil
+}
+
+type serviceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*serviceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_applicationsignals_service"
+}
+
+func (d *serviceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time":   timeRangeAttribute(),
+			names.AttrID: framework.IDAttribute(),
+			"key_attributes": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"metric_references": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metricReferenceModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[metricReferenceModel](ctx),
+				},
+			},
+			names.AttrStartTime: timeRangeAttribute(),
+		},
+	}
+}
+
+func (d *serviceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data serviceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	startTime, endTime, diags := expandTimeRange(data.StartTime, data.EndTime)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	input := &applicationsignals.GetServiceInput{
+		EndTime:       aws.Time(endTime),
+		KeyAttributes: fwflex.ExpandFrameworkStringValueMap(ctx, data.KeyAttributes),
+		StartTime:     aws.Time(startTime),
+	}
+
+	service, err := findService(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Application Signals Service", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, service.MetricReferences, &data.MetricReferences)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.EndTime = timetypes.NewRFC3339TimeValue(endTime)
+	data.ID = fwflex.StringValueToFramework(ctx, service.KeyAttributes["Name"])
+	data.StartTime = timetypes.NewRFC3339TimeValue(startTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findService(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.GetServiceInput) (*awstypes.Service, error) {
+	output, err := conn.GetService(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Service == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Service, nil
+}
+
+type serviceDataSourceModel struct {
+	EndTime          timetypes.RFC3339                                     `tfsdk:"end_time"`
+	ID               types.String                                          `tfsdk:"id"`
+	KeyAttributes    fwtypes.MapValueOf[types.String]                      `tfsdk:"key_attributes"`
+	MetricReferences fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+	StartTime        timetypes.RFC3339                                     `tfsdk:"start_time"`
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_applicationsignals_service.test"
+	servicesDataSourceName := "data.aws_applicationsignals_services.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckServices(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_attributes.%", servicesDataSourceName, "services.0.key_attributes.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_attributes.Name", servicesDataSourceName, "services.0.key_attributes.Name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metric_references.#"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckServices skips the test if no services have been discovered in the past day.
+func testAccPreCheckServices(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	endTime := time.Now()
+	input := &applicationsignals.ListServicesInput{
+		EndTime:   aws.Time(endTime),
+		StartTime: aws.Time(endTime.Add(-24 * time.Hour)),
+	}
+	output, err := conn.ListServices(ctx, input)
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+
+	if len(output.ServiceSummaries) == 0 {
+		t.Skip("skipping acceptance testing: no Application Signals services discovered")
+	}
+}
+
+const testAccServiceDataSourceConfig_basic = `
+data "aws_applicationsignals_services" "test" {}
+
+data "aws_applicationsignals_service" "test" {
+  key_attributes = data.aws_applicationsignals_services.test.services[0].key_attributes
+  start_time     = data.aws_applicationsignals_services.test.start_time
+  end_time       = data.aws_applicationsignals_services.test.end_time
+}
+`
@@ -0,0 +1,1032 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &serviceLevelObjectiveResource{}, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*serviceLevelObjectiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_applicationsignals_service_level_objective"
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"created_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]*$`), "must begin with a letter or number and contain only letters, numbers, underscores (_) and hyphens (-)"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configurations": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 10080),
+							},
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrInterval: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: durationAttribute(),
+												"duration_unit":    durationUnitAttribute(),
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: durationAttribute(),
+												"duration_unit":    durationUnitAttribute(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSliConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSliMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										PlanModifiers: []planmodifier.Map{
+											mapplanmodifier.RequiresReplace(),
+										},
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric":  monitoredRequestCountMetricDataQueryBlock(ctx),
+												"good_count_metric": monitoredRequestCountMetricDataQueryBlock(ctx),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ExactlyOneOf(
+						path.MatchRoot("request_based_sli"),
+						path.MatchRoot("sli"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										PlanModifiers: []planmodifier.Map{
+											mapplanmodifier.RequiresReplace(),
+										},
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.RequiresReplace(),
+										},
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									"period_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(60, 900),
+										},
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 20),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"metric_data_query": metricDataQueryBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func durationAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func durationUnitAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+		Required:   true,
+	}
+}
+
+func metricDataQueryBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAccountID: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.AWSAccountID(),
+					},
+				},
+				names.AttrExpression: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 2048),
+					},
+				},
+				names.AttrID: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 255),
+					},
+				},
+				"label": schema.StringAttribute{
+					Optional: true,
+				},
+				"period": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"return_data": schema.BoolAttribute{
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"metric_stat": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"period": schema.Int64Attribute{
+								Required: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"stat": schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrUnit: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+								Optional:   true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"metric": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrMetricName: schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthBetween(1, 255),
+											},
+										},
+										names.AttrNamespace: schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthBetween(1, 255),
+											},
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"dimension": schema.SetNestedBlock{
+											CustomType: fwtypes.NewSetNestedObjectTypeOf[dimensionModel](ctx),
+											Validators: []validator.Set{
+												setvalidator.SizeAtMost(30),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													names.AttrName: schema.StringAttribute{
+														Required: true,
+														Validators: []validator.String{
+															stringvalidator.LengthBetween(1, 255),
+														},
+													},
+													names.AttrValue: schema.StringAttribute{
+														Required: true,
+														Validators: []validator.String{
+															stringvalidator.LengthBetween(1, 1024),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// monitoredRequestCountMetricDataQueryBlock returns the schema for one of the
+// mutually exclusive good or bad request count metrics.
+func monitoredRequestCountMetricDataQueryBlock(ctx context.Context) schema.ListNestedBlock {
+	block := metricDataQueryBlock(ctx)
+	block.Validators = []validator.List{
+		listvalidator.ExactlyOneOf(
+			path.MatchRelative().AtParent().AtName("bad_count_metric"),
+			path.MatchRelative().AtParent().AtName("good_count_metric"),
+		),
+	}
+
+	return block
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	input := &applicationsignals.CreateServiceLevelObjectiveInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Signals Service Level Objective (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output.Slo)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	output, err := findServiceLevelObjectiveByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	if !new.BurnRateConfigurations.Equal(old.BurnRateConfigurations) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Goal.Equal(old.Goal) ||
+		!new.RequestBasedSliConfig.Equal(old.RequestBasedSliConfig) ||
+		!new.SliConfig.Equal(old.SliConfig) {
+		input := &applicationsignals.UpdateServiceLevelObjectiveInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Id = aws.String(new.ID.ValueString())
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Application Signals Service Level Objective (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output.Slo)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.LastUpdatedTime = old.LastUpdatedTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	_, err := conn.DeleteServiceLevelObjective(ctx, &applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Signals Service Level Objective (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var old, new serviceLevelObjectiveResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// An SLO cannot be changed between period-based and request-based.
+		if new.evaluationType() != old.evaluationType() {
+			response.RequiresReplace = []path.Path{path.Root("request_based_sli"), path.Root("sli")}
+		}
+	}
+
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := &applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configurations"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	ID                     types.String                                                `tfsdk:"id"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSliConfig  fwtypes.ListNestedObjectValueOf[requestBasedSliConfigModel] `tfsdk:"request_based_sli"`
+	SliConfig              fwtypes.ListNestedObjectValueOf[sliConfigModel]             `tfsdk:"sli"`
+	Tags                   types.Map                                                   `tfsdk:"tags"`
+	TagsAll                types.Map                                                   `tfsdk:"tags_all"`
+}
+
+func (data *serviceLevelObjectiveResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *serviceLevelObjectiveResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+func (data *serviceLevelObjectiveResourceModel) evaluationType() awstypes.EvaluationType {
+	switch {
+	case !data.RequestBasedSliConfig.IsNull():
+		return awstypes.EvaluationTypeRequestBased
+	case !data.SliConfig.IsNull():
+		return awstypes.EvaluationTypePeriodBased
+	}
+
+	return ""
+}
+
+// flatten sets the model from the API representation of the SLO.
+// The SLI is returned in a different shape than it is configured, so only the
+// attributes that are returned are refreshed.
+func (data *serviceLevelObjectiveResourceModel) flatten(ctx context.Context, apiObject *awstypes.ServiceLevelObjective) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.flattenSli(ctx, apiObject.Sli)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.flattenRequestBasedSli(ctx, apiObject.RequestBasedSli)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
+func (data *serviceLevelObjectiveResourceModel) flattenSli(ctx context.Context, sli *awstypes.ServiceLevelIndicator) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sli == nil || sli.SliMetric == nil {
+		return diags
+	}
+
+	sliConfig, d := data.SliConfig.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sliConfig == nil {
+		sliConfig = &sliConfigModel{
+			SliMetricConfig: fwtypes.NewListNestedObjectValueOfNull[sliMetricConfigModel](ctx),
+		}
+	}
+
+	sliConfig.ComparisonOperator = fwtypes.StringEnumValue(sli.ComparisonOperator)
+	sliConfig.MetricThreshold = fwflex.Float64ToFramework(ctx, sli.MetricThreshold)
+
+	sliMetricConfig, d := sliConfig.SliMetricConfig.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sliMetricConfig == nil {
+		sliMetricConfig = &sliMetricConfigModel{
+			MetricDataQueries: fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx),
+			PeriodSeconds:     types.Int64Null(),
+			Statistic:         types.StringNull(),
+		}
+	}
+
+	if v := sli.SliMetric.KeyAttributes; len(v) > 0 {
+		sliMetricConfig.KeyAttributes = fwtypes.MapValueOf[types.String]{MapValue: fwflex.FlattenFrameworkStringValueMap(ctx, v)}
+		sliMetricConfig.MetricType = fwtypes.StringEnumValue(sli.SliMetric.MetricType)
+		sliMetricConfig.OperationName = fwflex.StringToFramework(ctx, sli.SliMetric.OperationName)
+	} else {
+		// Metric data queries are generated by the service for SLIs based on key attributes.
+		diags.Append(fwflex.Flatten(ctx, sli.SliMetric.MetricDataQueries, &sliMetricConfig.MetricDataQueries)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	sliConfig.SliMetricConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliMetricConfig)
+	data.SliConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliConfig)
+
+	return diags
+}
+
+func (data *serviceLevelObjectiveResourceModel) flattenRequestBasedSli(ctx context.Context, sli *awstypes.RequestBasedServiceLevelIndicator) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sli == nil || sli.RequestBasedSliMetric == nil {
+		return diags
+	}
+
+	sliConfig, d := data.RequestBasedSliConfig.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sliConfig == nil {
+		sliConfig = &requestBasedSliConfigModel{
+			RequestBasedSliMetricConfig: fwtypes.NewListNestedObjectValueOfNull[requestBasedSliMetricConfigModel](ctx),
+		}
+	}
+
+	diags.Append(fwflex.Flatten(ctx, sli, sliConfig)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sliMetricConfig, d := sliConfig.RequestBasedSliMetricConfig.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sliMetricConfig == nil {
+		sliMetricConfig = &requestBasedSliMetricConfigModel{
+			MonitoredRequestCountMetric: fwtypes.NewListNestedObjectValueOfNull[monitoredRequestCountMetricModel](ctx),
+			TotalRequestCountMetric:     fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx),
+		}
+	}
+
+	if v := sli.RequestBasedSliMetric.KeyAttributes; len(v) > 0 {
+		sliMetricConfig.KeyAttributes = fwtypes.MapValueOf[types.String]{MapValue: fwflex.FlattenFrameworkStringValueMap(ctx, v)}
+		sliMetricConfig.MetricType = fwtypes.StringEnumValue(sli.RequestBasedSliMetric.MetricType)
+		sliMetricConfig.OperationName = fwflex.StringToFramework(ctx, sli.RequestBasedSliMetric.OperationName)
+	} else {
+		// Metric data queries are generated by the service for SLIs based on key attributes.
+		diags.Append(fwflex.Flatten(ctx, sli.RequestBasedSliMetric, sliMetricConfig)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	sliConfig.RequestBasedSliMetricConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliMetricConfig)
+	data.RequestBasedSliConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sliConfig)
+
+	return diags
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int64 `tfsdk:"look_back_window_minutes"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.CalendarInterval = fwtypes.NewListNestedObjectValueOfNull[calendarIntervalModel](ctx)
+	m.RollingInterval = fwtypes.NewListNestedObjectValueOfNull[rollingIntervalModel](ctx)
+
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model rollingIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+	}
+
+	return diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int64                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int64                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type requestBasedSliConfigModel struct {
+	ComparisonOperator          fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold             types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSliMetricConfig fwtypes.ListNestedObjectValueOf[requestBasedSliMetricConfigModel]    `tfsdk:"request_based_sli_metric"`
+}
+
+type requestBasedSliMetricConfigModel struct {
+	KeyAttributes               fwtypes.MapValueOf[types.String]                                  `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GoodCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.BadCountMetric = fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx)
+	m.GoodCountMetric = fwtypes.NewListNestedObjectValueOfNull[metricDataQueryModel](ctx)
+
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+	}
+
+	return diags
+}
+
+type sliConfigModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SliMetricConfig    fwtypes.ListNestedObjectValueOf[sliMetricConfigModel]                `tfsdk:"sli_metric"`
+}
+
+type sliMetricConfigModel struct {
+	KeyAttributes     fwtypes.MapValueOf[types.String]                             `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int64                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int64                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int64                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.SetNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                   `tfsdk:"metric_name"`
+	Namespace  types.String                                   `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,562 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "application-signals", regexache.MustCompile(`slo/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "goal.0.attainment_goal"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", "DAY"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", "LessThan"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "90"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.id", "m1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.metric_stat.0.metric.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfapplicationsignals.ResourceServiceLevelObjective, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated test SLO"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.5"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.warning_threshold", "50"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.duration_unit", "MONTH"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.calendar_interval.0.start_time", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", "LessThanOrEqualTo"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.metric_threshold", "80"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBasedSLI(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBasedSLI(rName, "bad_count_metric", "HTTPCode_Target_5XX_Count"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "request_based_sli.0.comparison_operator"),
+					resource.TestCheckNoResourceAttr(resourceName, "request_based_sli.0.metric_threshold"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.bad_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.bad_count_metric.0.metric_stat.0.metric.0.metric_name", "HTTPCode_Target_5XX_Count"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.good_count_metric.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.total_request_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.total_request_count_metric.0.metric_stat.0.metric.0.metric_name", "RequestCount"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBasedSLI(rName, "good_count_metric", "HTTPCode_Target_2XX_Count"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.bad_count_metric.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.good_count_metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.good_count_metric.0.metric_stat.0.metric.0.metric_name", "HTTPCode_Target_2XX_Count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_burnRateConfigurations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfigurations1(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.0.look_back_window_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateConfigurations2(rName, 60, 360),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.0.look_back_window_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configurations.1.look_back_window_minutes", "360"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, n string, v *awstypes.ServiceLevelObjective) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+		output, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationSignalsClient(ctx)
+
+	input := &applicationsignals.ListServiceLevelObjectivesInput{}
+	_, err := conn.ListServiceLevelObjectives(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+const testAccServiceLevelObjectiveConfig_sli = `
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 90
+
+    sli_metric {
+      metric_data_query {
+        id          = "m1"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            namespace   = "AWS/EC2"
+            metric_name = "CPUUtilization"
+          }
+        }
+      }
+    }
+  }
+`
+
+func testAccServiceLevelObjectiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[2]s
+}
+`, rName, testAccServiceLevelObjectiveConfig_sli)
+}
+
+func testAccServiceLevelObjectiveConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name        = %[1]q
+  description = "updated test SLO"
+
+  goal {
+    attainment_goal   = 99.5
+    warning_threshold = 50
+
+    interval {
+      calendar_interval {
+        duration      = 1
+        duration_unit = "MONTH"
+        start_time    = "2024-01-01T00:00:00Z"
+      }
+    }
+  }
+
+  sli {
+    comparison_operator = "LessThanOrEqualTo"
+    metric_threshold    = 80
+
+    sli_metric {
+      metric_data_query {
+        id          = "m1"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            namespace   = "AWS/EC2"
+            metric_name = "CPUUtilization"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[4]s
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccServiceLevelObjectiveConfig_sli)
+}
+
+func testAccServiceLevelObjectiveConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[6]s
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccServiceLevelObjectiveConfig_sli)
+}
+
+func testAccServiceLevelObjectiveConfig_requestBasedSLI(rName, monitoredRequestCountMetric, metricName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  goal {
+    attainment_goal = 99.9
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  request_based_sli {
+    request_based_sli_metric {
+      monitored_request_count_metric {
+        %[2]s {
+          id          = "m1"
+          return_data = true
+
+          metric_stat {
+            period = 60
+            stat   = "Sum"
+
+            metric {
+              namespace   = "AWS/ApplicationELB"
+              metric_name = %[3]q
+            }
+          }
+        }
+      }
+
+      total_request_count_metric {
+        id          = "m2"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Sum"
+
+          metric {
+            namespace   = "AWS/ApplicationELB"
+            metric_name = "RequestCount"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, monitoredRequestCountMetric, metricName)
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateConfigurations1(rName string, lookBackWindowMinutes1 int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  burn_rate_configurations {
+    look_back_window_minutes = %[2]d
+  }
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[3]s
+}
+`, rName, lookBackWindowMinutes1, testAccServiceLevelObjectiveConfig_sli)
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateConfigurations2(rName string, lookBackWindowMinutes1, lookBackWindowMinutes2 int) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  burn_rate_configurations {
+    look_back_window_minutes = %[2]d
+  }
+
+  burn_rate_configurations {
+    look_back_window_minutes = %[3]d
+  }
+
+  goal {
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+%[4]s
+}
+`, rName, lookBackWindowMinutes1, lookBackWindowMinutes2, testAccServiceLevelObjectiveConfig_sli)
+}
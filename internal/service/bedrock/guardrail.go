@@ -0,0 +1,794 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Guardrail")
+// @Tags(identifierAttribute="guardrail_arn")
+func newGuardrailResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &guardrailResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type guardrailResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *guardrailResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_guardrail"
+}
+
+func (r *guardrailResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blocked_input_messaging": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			"blocked_outputs_messaging": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"guardrail_arn": framework.ARNAttributeComputedOnly(),
+			"guardrail_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GuardrailStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"content_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailContentPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"filters_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailContentFilterModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeBetween(1, 6),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"input_strength": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailFilterStrength](),
+										Required:   true,
+									},
+									"output_strength": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailFilterStrength](),
+										Required:   true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailContentFilterType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"contextual_grounding_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailContextualGroundingPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"filters_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailContextualGroundingFilterModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"threshold": schema.Float64Attribute{
+										Required: true,
+										Validators: []validator.Float64{
+											float64validator.Between(0, 0.99),
+										},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailContextualGroundingFilterType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sensitive_information_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailSensitiveInformationPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"pii_entities_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailPIIEntityModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAction: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailSensitiveInformationAction](),
+										Required:   true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailPiiEntityType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"regexes_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailRegexModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(10),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAction: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailSensitiveInformationAction](),
+										Required:   true,
+									},
+									names.AttrDescription: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1000),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100),
+										},
+									},
+									"pattern": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"topic_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailTopicPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"topics_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailTopicModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeBetween(1, 30),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"definition": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 200),
+										},
+									},
+									"examples": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(5),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100),
+										},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailTopicType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"word_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailWordPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"managed_word_lists_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailManagedWordsModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailManagedWordsType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"words_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailWordModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"text": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *guardrailResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data guardrailResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	input := &bedrock.CreateGuardrailInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policies, diags := data.expandPolicyConfigs(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.ContentPolicyConfig = policies.contentPolicyConfig
+	input.ContextualGroundingPolicyConfig = policies.contextualGroundingPolicyConfig
+	input.SensitiveInformationPolicyConfig = policies.sensitiveInformationPolicyConfig
+	input.Tags = getTagsIn(ctx)
+	input.TopicPolicyConfig = policies.topicPolicyConfig
+	input.WordPolicyConfig = policies.wordPolicyConfig
+
+	name := data.Name.ValueString()
+	output, err := conn.CreateGuardrail(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Guardrail (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.GuardrailARN = fwflex.StringToFramework(ctx, output.GuardrailArn)
+	data.GuardrailID = fwflex.StringToFramework(ctx, output.GuardrailId)
+	data.setID()
+
+	guardrail, err := waitGuardrailCreated(ctx, conn, data.GuardrailID.ValueString(), aws.ToString(output.Version), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CreatedAt = fwflex.TimeToFramework(ctx, guardrail.CreatedAt)
+	data.Status = fwtypes.StringEnumValue(guardrail.Status)
+	data.Version = fwflex.StringToFramework(ctx, guardrail.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *guardrailResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data guardrailResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findGuardrailByTwoPartKey(ctx, conn, data.GuardrailID.ValueString(), guardrailVersionDraft)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Guardrail (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Different field name on Get.
+	data.KMSKeyID = fwflex.StringToFrameworkARN(ctx, output.KmsKeyArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *guardrailResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new guardrailResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if !new.BlockedInputMessaging.Equal(old.BlockedInputMessaging) ||
+		!new.BlockedOutputsMessaging.Equal(old.BlockedOutputsMessaging) ||
+		!new.ContentPolicy.Equal(old.ContentPolicy) ||
+		!new.ContextualGroundingPolicy.Equal(old.ContextualGroundingPolicy) ||
+		!new.Description.Equal(old.Description) ||
+		!new.KMSKeyID.Equal(old.KMSKeyID) ||
+		!new.Name.Equal(old.Name) ||
+		!new.SensitiveInformationPolicy.Equal(old.SensitiveInformationPolicy) ||
+		!new.TopicPolicy.Equal(old.TopicPolicy) ||
+		!new.WordPolicy.Equal(old.WordPolicy) {
+		input := &bedrock.UpdateGuardrailInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		policies, diags := new.expandPolicyConfigs(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ContentPolicyConfig = policies.contentPolicyConfig
+		input.ContextualGroundingPolicyConfig = policies.contextualGroundingPolicyConfig
+		input.GuardrailIdentifier = fwflex.StringFromFramework(ctx, new.GuardrailID)
+		input.SensitiveInformationPolicyConfig = policies.sensitiveInformationPolicyConfig
+		input.TopicPolicyConfig = policies.topicPolicyConfig
+		input.WordPolicyConfig = policies.wordPolicyConfig
+
+		_, err := conn.UpdateGuardrail(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Guardrail (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		guardrail, err := waitGuardrailUpdated(ctx, conn, new.GuardrailID.ValueString(), guardrailVersionDraft, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(guardrail.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *guardrailResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data guardrailResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	_, err := conn.DeleteGuardrail(ctx, &bedrock.DeleteGuardrailInput{
+		GuardrailIdentifier: fwflex.StringFromFramework(ctx, data.GuardrailID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Guardrail (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitGuardrailDeleted(ctx, conn, data.GuardrailID.ValueString(), guardrailVersionDraft, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *guardrailResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	guardrailVersionDraft = "DRAFT"
+)
+
+func findGuardrailByTwoPartKey(ctx context.Context, conn *bedrock.Client, id, version string) (*bedrock.GetGuardrailOutput, error) {
+	input := &bedrock.GetGuardrailInput{
+		GuardrailIdentifier: aws.String(id),
+		GuardrailVersion:    aws.String(version),
+	}
+
+	output, err := conn.GetGuardrail(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGuardrail(ctx context.Context, conn *bedrock.Client, id, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGuardrailByTwoPartKey(ctx, conn, id, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GuardrailStatusCreating, awstypes.GuardrailStatusVersioning),
+		Target:  enum.Slice(awstypes.GuardrailStatusReady),
+		Refresh: statusGuardrail(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.StatusReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGuardrailUpdated(ctx context.Context, conn *bedrock.Client, id, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GuardrailStatusUpdating),
+		Target:  enum.Slice(awstypes.GuardrailStatusReady),
+		Refresh: statusGuardrail(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.StatusReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGuardrailDeleted(ctx context.Context, conn *bedrock.Client, id, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GuardrailStatusDeleting),
+		Target:  []string{},
+		Refresh: statusGuardrail(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.StatusReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// The model's policy field names follow the GetGuardrail output shapes, e.g. ContentPolicy.Filters.
+// The equivalent CreateGuardrail and UpdateGuardrail input shapes are named differently, e.g. ContentPolicyConfig.FiltersConfig.
+type guardrailResourceModel struct {
+	BlockedInputMessaging      types.String                                                              `tfsdk:"blocked_input_messaging"`
+	BlockedOutputsMessaging    types.String                                                              `tfsdk:"blocked_outputs_messaging"`
+	ContentPolicy              fwtypes.ListNestedObjectValueOf[guardrailContentPolicyModel]              `tfsdk:"content_policy_config"`
+	ContextualGroundingPolicy  fwtypes.ListNestedObjectValueOf[guardrailContextualGroundingPolicyModel]  `tfsdk:"contextual_grounding_policy_config"`
+	CreatedAt                  timetypes.RFC3339                                                         `tfsdk:"created_at"`
+	Description                types.String                                                              `tfsdk:"description"`
+	GuardrailARN               types.String                                                              `tfsdk:"guardrail_arn"`
+	GuardrailID                types.String                                                              `tfsdk:"guardrail_id"`
+	ID                         types.String                                                              `tfsdk:"id"`
+	KMSKeyID                   fwtypes.ARN                                                               `tfsdk:"kms_key_arn"`
+	Name                       types.String                                                              `tfsdk:"name"`
+	SensitiveInformationPolicy fwtypes.ListNestedObjectValueOf[guardrailSensitiveInformationPolicyModel] `tfsdk:"sensitive_information_policy_config"`
+	Status                     fwtypes.StringEnum[awstypes.GuardrailStatus]                              `tfsdk:"status"`
+	Tags                       types.Map                                                                 `tfsdk:"tags"`
+	TagsAll                    types.Map                                                                 `tfsdk:"tags_all"`
+	Timeouts                   timeouts.Value                                                            `tfsdk:"timeouts"`
+	TopicPolicy                fwtypes.ListNestedObjectValueOf[guardrailTopicPolicyModel]                `tfsdk:"topic_policy_config"`
+	Version                    types.String                                                              `tfsdk:"version"`
+	WordPolicy                 fwtypes.ListNestedObjectValueOf[guardrailWordPolicyModel]                 `tfsdk:"word_policy_config"`
+}
+
+func (data *guardrailResourceModel) InitFromID() error {
+	data.GuardrailID = data.ID
+
+	return nil
+}
+
+func (data *guardrailResourceModel) setID() {
+	data.ID = data.GuardrailID
+}
+
+type guardrailPolicyConfigs struct {
+	contentPolicyConfig              *awstypes.GuardrailContentPolicyConfig
+	contextualGroundingPolicyConfig  *awstypes.GuardrailContextualGroundingPolicyConfig
+	sensitiveInformationPolicyConfig *awstypes.GuardrailSensitiveInformationPolicyConfig
+	topicPolicyConfig                *awstypes.GuardrailTopicPolicyConfig
+	wordPolicyConfig                 *awstypes.GuardrailWordPolicyConfig
+}
+
+// expandPolicyConfigs expands the model's policies into their CreateGuardrail and UpdateGuardrail input shapes.
+// The nested elements have the same field names in the input and output shapes and are expanded by AutoFlex.
+func (data *guardrailResourceModel) expandPolicyConfigs(ctx context.Context) (*guardrailPolicyConfigs, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiObjects := &guardrailPolicyConfigs{}
+
+	contentPolicy, d := data.ContentPolicy.ToPtr(ctx)
+	diags.Append(d...)
+	contextualGroundingPolicy, d := data.ContextualGroundingPolicy.ToPtr(ctx)
+	diags.Append(d...)
+	sensitiveInformationPolicy, d := data.SensitiveInformationPolicy.ToPtr(ctx)
+	diags.Append(d...)
+	topicPolicy, d := data.TopicPolicy.ToPtr(ctx)
+	diags.Append(d...)
+	wordPolicy, d := data.WordPolicy.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if contentPolicy != nil {
+		apiObjects.contentPolicyConfig = &awstypes.GuardrailContentPolicyConfig{}
+		diags.Append(fwflex.Expand(ctx, contentPolicy.Filters, &apiObjects.contentPolicyConfig.FiltersConfig)...)
+	}
+
+	if contextualGroundingPolicy != nil {
+		apiObjects.contextualGroundingPolicyConfig = &awstypes.GuardrailContextualGroundingPolicyConfig{}
+		diags.Append(fwflex.Expand(ctx, contextualGroundingPolicy.Filters, &apiObjects.contextualGroundingPolicyConfig.FiltersConfig)...)
+	}
+
+	if sensitiveInformationPolicy != nil {
+		apiObjects.sensitiveInformationPolicyConfig = &awstypes.GuardrailSensitiveInformationPolicyConfig{}
+		diags.Append(fwflex.Expand(ctx, sensitiveInformationPolicy.PIIEntities, &apiObjects.sensitiveInformationPolicyConfig.PiiEntitiesConfig)...)
+		diags.Append(fwflex.Expand(ctx, sensitiveInformationPolicy.Regexes, &apiObjects.sensitiveInformationPolicyConfig.RegexesConfig)...)
+	}
+
+	if topicPolicy != nil {
+		apiObjects.topicPolicyConfig = &awstypes.GuardrailTopicPolicyConfig{}
+		diags.Append(fwflex.Expand(ctx, topicPolicy.Topics, &apiObjects.topicPolicyConfig.TopicsConfig)...)
+	}
+
+	if wordPolicy != nil {
+		apiObjects.wordPolicyConfig = &awstypes.GuardrailWordPolicyConfig{}
+		diags.Append(fwflex.Expand(ctx, wordPolicy.ManagedWordLists, &apiObjects.wordPolicyConfig.ManagedWordListsConfig)...)
+		diags.Append(fwflex.Expand(ctx, wordPolicy.Words, &apiObjects.wordPolicyConfig.WordsConfig)...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return apiObjects, diags
+}
+
+type guardrailContentPolicyModel struct {
+	Filters fwtypes.SetNestedObjectValueOf[guardrailContentFilterModel] `tfsdk:"filters_config"`
+}
+
+type guardrailContentFilterModel struct {
+	InputStrength  fwtypes.StringEnum[awstypes.GuardrailFilterStrength]    `tfsdk:"input_strength"`
+	OutputStrength fwtypes.StringEnum[awstypes.GuardrailFilterStrength]    `tfsdk:"output_strength"`
+	Type           fwtypes.StringEnum[awstypes.GuardrailContentFilterType] `tfsdk:"type"`
+}
+
+type guardrailContextualGroundingPolicyModel struct {
+	Filters fwtypes.SetNestedObjectValueOf[guardrailContextualGroundingFilterModel] `tfsdk:"filters_config"`
+}
+
+type guardrailContextualGroundingFilterModel struct {
+	Threshold types.Float64                                                       `tfsdk:"threshold"`
+	Type      fwtypes.StringEnum[awstypes.GuardrailContextualGroundingFilterType] `tfsdk:"type"`
+}
+
+type guardrailSensitiveInformationPolicyModel struct {
+	PIIEntities fwtypes.SetNestedObjectValueOf[guardrailPIIEntityModel] `tfsdk:"pii_entities_config"`
+	Regexes     fwtypes.SetNestedObjectValueOf[guardrailRegexModel]     `tfsdk:"regexes_config"`
+}
+
+type guardrailPIIEntityModel struct {
+	Action fwtypes.StringEnum[awstypes.GuardrailSensitiveInformationAction] `tfsdk:"action"`
+	Type   fwtypes.StringEnum[awstypes.GuardrailPiiEntityType]              `tfsdk:"type"`
+}
+
+type guardrailRegexModel struct {
+	Action      fwtypes.StringEnum[awstypes.GuardrailSensitiveInformationAction] `tfsdk:"action"`
+	Description types.String                                                     `tfsdk:"description"`
+	Name        types.String                                                     `tfsdk:"name"`
+	Pattern     types.String                                                     `tfsdk:"pattern"`
+}
+
+type guardrailTopicPolicyModel struct {
+	Topics fwtypes.SetNestedObjectValueOf[guardrailTopicModel] `tfsdk:"topics_config"`
+}
+
+type guardrailTopicModel struct {
+	Definition types.String                                    `tfsdk:"definition"`
+	Examples   fwtypes.ListValueOf[types.String]               `tfsdk:"examples"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.GuardrailTopicType] `tfsdk:"type"`
+}
+
+type guardrailWordPolicyModel struct {
+	ManagedWordLists fwtypes.SetNestedObjectValueOf[guardrailManagedWordsModel] `tfsdk:"managed_word_lists_config"`
+	Words            fwtypes.SetNestedObjectValueOf[guardrailWordModel]         `tfsdk:"words_config"`
+}
+
+type guardrailManagedWordsModel struct {
+	Type fwtypes.StringEnum[awstypes.GuardrailManagedWordsType] `tfsdk:"type"`
+}
+
+type guardrailWordModel struct {
+	Text types.String `tfsdk:"text"`
+}
@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockGuardrail_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetGuardrailOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocked_input_messaging", "input blocked"),
+					resource.TestCheckResourceAttr(resourceName, "blocked_outputs_messaging", "output blocked"),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.0.filters_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "content_policy_config.0.filters_config.*", map[string]string{
+						"input_strength":  "HIGH",
+						"output_strength": "MEDIUM",
+						names.AttrType:    "HATE",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "guardrail_arn", "bedrock", regexache.MustCompile(`guardrail/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "guardrail_id"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrKMSKeyARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_information_policy_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "topic_policy_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "word_policy_config.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrail_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetGuardrailOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceGuardrail, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrail_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetGuardrailOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardrailConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccGuardrailConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrail_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetGuardrailOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_information_policy_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "topic_policy_config.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccGuardrailConfig_allPolicies(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocked_input_messaging", "input blocked again"),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.0.filters_config.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "contextual_grounding_policy_config.0.filters_config.*", map[string]string{
+						"threshold":    "0.4",
+						names.AttrType: "GROUNDING",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_information_policy_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sensitive_information_policy_config.0.pii_entities_config.*", map[string]string{
+						names.AttrAction: "BLOCK",
+						names.AttrType:   "EMAIL",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sensitive_information_policy_config.0.regexes_config.*", map[string]string{
+						names.AttrAction: "ANONYMIZE",
+						names.AttrName:   "account_number",
+						"pattern":        "^\\d{8}$",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttr(resourceName, "topic_policy_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic_policy_config.0.topics_config.*", map[string]string{
+						names.AttrName: "investment_advice",
+						names.AttrType: "DENY",
+						"examples.#":   acctest.Ct1,
+						"examples.0":   "Where should I invest my money?",
+						"definition":   "Investment advice refers to recommendations about managing money.",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "word_policy_config.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "word_policy_config.0.managed_word_lists_config.*", map[string]string{
+						names.AttrType: "PROFANITY",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "word_policy_config.0.words_config.*", map[string]string{
+						"text": "HATE",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardrailConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocked_input_messaging", "input blocked"),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.#", acctest.Ct0),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_information_policy_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "topic_policy_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "word_policy_config.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrail_kmsKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetGuardrailOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailConfig_kmsKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyARN, "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGuardrailDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_guardrail" {
+				continue
+			}
+
+			_, err := tfbedrock.FindGuardrailByTwoPartKey(ctx, conn, rs.Primary.ID, "DRAFT")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Guardrail %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGuardrailExists(ctx context.Context, n string, v *bedrock.GetGuardrailOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		output, err := tfbedrock.FindGuardrailByTwoPartKey(ctx, conn, rs.Primary.ID, "DRAFT")
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGuardrailConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "input blocked"
+  blocked_outputs_messaging = "output blocked"
+
+  content_policy_config {
+    filters_config {
+      input_strength  = "HIGH"
+      output_strength = "MEDIUM"
+      type            = "HATE"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccGuardrailConfig_allPolicies(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "input blocked again"
+  blocked_outputs_messaging = "output blocked"
+  description               = "test"
+
+  content_policy_config {
+    filters_config {
+      input_strength  = "HIGH"
+      output_strength = "MEDIUM"
+      type            = "HATE"
+    }
+    filters_config {
+      input_strength  = "LOW"
+      output_strength = "LOW"
+      type            = "VIOLENCE"
+    }
+  }
+
+  contextual_grounding_policy_config {
+    filters_config {
+      threshold = 0.4
+      type      = "GROUNDING"
+    }
+  }
+
+  sensitive_information_policy_config {
+    pii_entities_config {
+      action = "BLOCK"
+      type   = "EMAIL"
+    }
+
+    regexes_config {
+      action      = "ANONYMIZE"
+      description = "Account number"
+      name        = "account_number"
+      pattern     = "^\\d{8}$"
+    }
+  }
+
+  topic_policy_config {
+    topics_config {
+      name       = "investment_advice"
+      definition = "Investment advice refers to recommendations about managing money."
+      examples   = ["Where should I invest my money?"]
+      type       = "DENY"
+    }
+  }
+
+  word_policy_config {
+    managed_word_lists_config {
+      type = "PROFANITY"
+    }
+
+    words_config {
+      text = "HATE"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccGuardrailConfig_kmsKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "input blocked"
+  blocked_outputs_messaging = "output blocked"
+  kms_key_arn               = aws_kms_key.test.arn
+
+  content_policy_config {
+    filters_config {
+      input_strength  = "HIGH"
+      output_strength = "MEDIUM"
+      type            = "HATE"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccGuardrailConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "input blocked"
+  blocked_outputs_messaging = "output blocked"
+
+  content_policy_config {
+    filters_config {
+      input_strength  = "HIGH"
+      output_strength = "MEDIUM"
+      type            = "HATE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccGuardrailConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "input blocked"
+  blocked_outputs_messaging = "output blocked"
+
+  content_policy_config {
+    filters_config {
+      input_strength  = "HIGH"
+      output_strength = "MEDIUM"
+      type            = "HATE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -44,6 +44,17 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "job_arn",
 			},
 		},
+		{
+			Factory: newGuardrailResource,
+			Name:    "Guardrail",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "guardrail_arn",
+			},
+		},
+		{
+			Factory: newGuardrailVersionResource,
+			Name:    "Guardrail Version",
+		},
 		{
 			Factory: newModelInvocationLoggingConfigurationResource,
 			Name:    "Model Invocation Logging Configuration",
@@ -10,12 +10,20 @@ var (
 	ResourceAgentAlias                    = newAgentAliasResource
 	ResourceAgentKnowledgeBaseAssociation = newAgentKnowledgeBaseAssociationResource
 	ResourceDataSource                    = newDataSourceResource
+	ResourceFlow                          = newFlowResource
+	ResourceFlowAlias                     = newFlowAliasResource
+	ResourceFlowVersion                   = newFlowVersionResource
 	ResourceKnowledgeBase                 = newKnowledgeBaseResource
+	ResourcePrompt                        = newPromptResource
 
 	FindAgentByID                                  = findAgentByID
 	FindAgentActionGroupByThreePartKey             = findAgentActionGroupByThreePartKey
 	FindAgentAliasByTwoPartKey                     = findAgentAliasByTwoPartKey
 	FindAgentKnowledgeBaseAssociationByThreePartID = findAgentKnowledgeBaseAssociationByThreePartKey
 	FindDataSourceByTwoPartKey                     = findDataSourceByTwoPartKey
+	FindFlowByID                                   = findFlowByID
+	FindFlowAliasByTwoPartKey                      = findFlowAliasByTwoPartKey
+	FindFlowVersionByTwoPartKey                    = findFlowVersionByTwoPartKey
 	FindKnowledgeBaseByID                          = findKnowledgeBaseByID
+	FindPromptByID                                 = findPromptByID
 )
@@ -0,0 +1,1979 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"definition_json": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("definition"),
+					),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"prepare_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"skip_resource_in_use_check": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"conditional": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("data"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrCondition: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"data": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"source_output": schema.StringAttribute{
+																Required: true,
+															},
+															"target_input": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"node": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: flowNodeConfigurationBlock(ctx),
+									"input": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrExpression: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func flowNodeConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	emptyBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeEmptyConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+		}
+	}
+	s3Block := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrBucketName: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"agent": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+						listvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("collector"),
+							path.MatchRelative().AtParent().AtName(names.AttrCondition),
+							path.MatchRelative().AtParent().AtName("input"),
+							path.MatchRelative().AtParent().AtName("iterator"),
+							path.MatchRelative().AtParent().AtName("knowledge_base"),
+							path.MatchRelative().AtParent().AtName("lambda_function"),
+							path.MatchRelative().AtParent().AtName("lex"),
+							path.MatchRelative().AtParent().AtName("output"),
+							path.MatchRelative().AtParent().AtName("prompt"),
+							path.MatchRelative().AtParent().AtName("retrieval"),
+							path.MatchRelative().AtParent().AtName("storage"),
+						),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"agent_alias_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+				"collector": emptyBlock(),
+				names.AttrCondition: schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							names.AttrCondition: schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeBetween(1, 5),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrExpression: schema.StringAttribute{
+											Optional: true,
+										},
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"input":    emptyBlock(),
+				"iterator": emptyBlock(),
+				"knowledge_base": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"knowledge_base_id": schema.StringAttribute{
+								Required: true,
+							},
+							"model_id": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+				"lambda_function": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"lambda_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+						},
+					},
+				},
+				"lex": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"bot_alias_arn": schema.StringAttribute{
+								CustomType: fwtypes.ARNType,
+								Required:   true,
+							},
+							"locale_id": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+				"output": emptyBlock(),
+				"prompt": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"source_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"inline": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ExactlyOneOf(
+													path.MatchRelative().AtParent().AtName("resource"),
+												),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"model_id": schema.StringAttribute{
+														Required: true,
+													},
+													"template_type": schema.StringAttribute{
+														CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+														Required:   true,
+													},
+												},
+												Blocks: map[string]schema.Block{
+													"inference_configuration": promptInferenceConfigurationBlock(ctx),
+													"template_configuration":  promptTemplateConfigurationBlock(ctx),
+												},
+											},
+										},
+										"resource": schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"prompt_arn": schema.StringAttribute{
+														CustomType: fwtypes.ARNType,
+														Required:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"retrieval": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"service_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeServiceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"s3": s3Block(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"storage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"service_configuration": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeServiceConfigurationModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Blocks: map[string]schema.Block{
+										"s3": s3Block(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DefinitionJSON.IsNull() {
+		definition, err := expandFlowDefinitionJSON(data.DefinitionJSON.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+			return
+		}
+
+		input.Definition = definition
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	if data.PrepareFlow.ValueBool() {
+		flow, err := prepareFlow(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError("creating Bedrock Agent Flow", err.Error())
+
+			return
+		}
+
+		data.Status = fwtypes.StringEnumValue(flow.Status)
+		data.UpdatedAt = timetypes.NewRFC3339TimePointerValue(flow.UpdatedAt)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The definition is configured either as blocks or as JSON. Keep reading it back in the same form.
+	if !data.DefinitionJSON.IsNull() {
+		definition, err := flattenFlowDefinitionJSON(output.Definition)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		data.Definition = fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx)
+		data.DefinitionJSON = jsontypes.NewNormalizedValue(definition)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	changed := !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.DefinitionJSON.Equal(old.DefinitionJSON) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name)
+
+	if changed {
+		input := &bedrockagent.UpdateFlowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !new.DefinitionJSON.IsNull() {
+			definition, err := expandFlowDefinitionJSON(new.DefinitionJSON.ValueString())
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			input.Definition = definition
+		}
+
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateFlow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if new.PrepareFlow.ValueBool() && (changed || !old.PrepareFlow.ValueBool()) {
+		if _, err := prepareFlow(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findFlowByID(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.Status = fwtypes.StringEnumValue(output.Status)
+	new.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.UpdatedAt)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier:         fwflex.StringFromFramework(ctx, data.ID),
+		SkipResourceInUseCheck: fwflex.BoolValueFromFramework(ctx, data.SkipResourceInUseCheck),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	output, err := waitFlowPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return output, nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		var errs []error
+		for _, v := range output.Validations {
+			errs = append(errs, errors.New(aws.ToString(v.Message)))
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	DefinitionJSON           jsontypes.Normalized                                 `tfsdk:"definition_json"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	PrepareFlow              types.Bool                                           `tfsdk:"prepare_flow"`
+	SkipResourceInUseCheck   types.Bool                                           `tfsdk:"skip_resource_in_use_check"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     types.Map                                            `tfsdk:"tags"`
+	TagsAll                  types.Map                                            `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+var (
+	_ fwflex.Expander  = flowConnectionConfigurationModel{}
+	_ fwflex.Flattener = &flowConnectionConfigurationModel{}
+)
+
+func (m flowConnectionConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Conditional.IsNull():
+		flowConditionalConnectionConfigurationData, d := m.Conditional.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowConnectionConfigurationMemberConditional
+		diags.Append(fwflex.Expand(ctx, flowConditionalConnectionConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Data.IsNull():
+		flowDataConnectionConfigurationData, d := m.Data.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowConnectionConfigurationMemberData
+		diags.Append(fwflex.Expand(ctx, flowDataConnectionConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowConnectionConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.FlowConnectionConfigurationMemberConditional:
+		var data flowConditionalConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowConnectionConfigurationMemberData:
+		var data flowDataConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Collector      fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"collector"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	Input          fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"input"`
+	Iterator       fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"iterator"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Output         fwtypes.ListNestedObjectValueOf[flowNodeEmptyConfigurationModel]          `tfsdk:"output"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[retrievalFlowNodeConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[storageFlowNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+var (
+	_ fwflex.Expander  = flowNodeConfigurationModel{}
+	_ fwflex.Flattener = &flowNodeConfigurationModel{}
+)
+
+func (m flowNodeConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Agent.IsNull():
+		agentFlowNodeConfigurationData, d := m.Agent.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberAgent
+		diags.Append(fwflex.Expand(ctx, agentFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Collector.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, diags
+
+	case !m.Condition.IsNull():
+		conditionFlowNodeConfigurationData, d := m.Condition.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberCondition
+		diags.Append(fwflex.Expand(ctx, conditionFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Input.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberInput{}, diags
+
+	case !m.Iterator.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, diags
+
+	case !m.KnowledgeBase.IsNull():
+		knowledgeBaseFlowNodeConfigurationData, d := m.KnowledgeBase.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberKnowledgeBase
+		diags.Append(fwflex.Expand(ctx, knowledgeBaseFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.LambdaFunction.IsNull():
+		lambdaFunctionFlowNodeConfigurationData, d := m.LambdaFunction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberLambdaFunction
+		diags.Append(fwflex.Expand(ctx, lambdaFunctionFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Lex.IsNull():
+		lexFlowNodeConfigurationData, d := m.Lex.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberLex
+		diags.Append(fwflex.Expand(ctx, lexFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Output.IsNull():
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, diags
+
+	case !m.Prompt.IsNull():
+		promptFlowNodeConfigurationData, d := m.Prompt.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberPrompt
+		diags.Append(fwflex.Expand(ctx, promptFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Retrieval.IsNull():
+		retrievalFlowNodeConfigurationData, d := m.Retrieval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberRetrieval
+		diags.Append(fwflex.Expand(ctx, retrievalFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Storage.IsNull():
+		storageFlowNodeConfigurationData, d := m.Storage.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.FlowNodeConfigurationMemberStorage
+		diags.Append(fwflex.Expand(ctx, storageFlowNodeConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *flowNodeConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.FlowNodeConfigurationMemberAgent:
+		var data agentFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberCollector:
+		m.Collector = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberCondition:
+		var data conditionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberInput:
+		m.Input = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberIterator:
+		m.Iterator = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var data knowledgeBaseFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var data lambdaFunctionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberLex:
+		var data lexFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberOutput:
+		m.Output = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeEmptyConfigurationModel{})
+
+	case awstypes.FlowNodeConfigurationMemberPrompt:
+		var data promptFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberRetrieval:
+		var data retrievalFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.FlowNodeConfigurationMemberStorage:
+		var data storageFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+// flowNodeEmptyConfigurationModel is used by node types that take no configuration.
+type flowNodeEmptyConfigurationModel struct{}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type conditionFlowNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	KnowledgeBaseID types.String `tfsdk:"knowledge_base_id"`
+	ModelID         types.String `tfsdk:"model_id"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type promptFlowNodeConfigurationModel struct {
+	SourceConfiguration fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+var (
+	_ fwflex.Expander  = promptFlowNodeSourceConfigurationModel{}
+	_ fwflex.Flattener = &promptFlowNodeSourceConfigurationModel{}
+)
+
+func (m promptFlowNodeSourceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Inline.IsNull():
+		promptFlowNodeInlineConfigurationData, d := m.Inline.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberInline
+		diags.Append(fwflex.Expand(ctx, promptFlowNodeInlineConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.Resource.IsNull():
+		promptFlowNodeResourceConfigurationData, d := m.Resource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PromptFlowNodeSourceConfigurationMemberResource
+		diags.Append(fwflex.Expand(ctx, promptFlowNodeResourceConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *promptFlowNodeSourceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		var data promptFlowNodeInlineConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		var data promptFlowNodeResourceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration  fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType           fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type retrievalFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[retrievalFlowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type retrievalFlowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = retrievalFlowNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &retrievalFlowNodeServiceConfigurationModel{}
+)
+
+func (m retrievalFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3.IsNull():
+		flowNodeS3ConfigurationData, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RetrievalFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, flowNodeS3ConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *retrievalFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RetrievalFlowNodeServiceConfigurationMemberS3:
+		var data flowNodeS3ConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type storageFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[storageFlowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type storageFlowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = storageFlowNodeServiceConfigurationModel{}
+	_ fwflex.Flattener = &storageFlowNodeServiceConfigurationModel{}
+)
+
+func (m storageFlowNodeServiceConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3.IsNull():
+		flowNodeS3ConfigurationData, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.StorageFlowNodeServiceConfigurationMemberS3
+		diags.Append(fwflex.Expand(ctx, flowNodeS3ConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *storageFlowNodeServiceConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.StorageFlowNodeServiceConfigurationMemberS3:
+		var data flowNodeS3ConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+// The JSON form of a flow definition uses the same shape as the Bedrock Agents API
+// (and the AWS Console export), e.g.
+//
+//	{"nodes": [{"name": "FlowInput", "type": "Input", "configuration": {"input": {}}, ...}], "connections": [...]}
+//
+// The AWS SDK's union types can't be (un)marshaled by encoding/json, so mirror them here.
+
+type flowDefinitionJSON struct {
+	Connections []flowConnectionJSON `json:"connections,omitempty"`
+	Nodes       []flowNodeJSON       `json:"nodes,omitempty"`
+}
+
+type flowConnectionJSON struct {
+	Configuration *flowConnectionConfigurationJSON `json:"configuration,omitempty"`
+	Name          *string                          `json:"name,omitempty"`
+	Source        *string                          `json:"source,omitempty"`
+	Target        *string                          `json:"target,omitempty"`
+	Type          awstypes.FlowConnectionType      `json:"type,omitempty"`
+}
+
+type flowConnectionConfigurationJSON struct {
+	Conditional *flowConditionalConnectionConfigurationJSON `json:"conditional,omitempty"`
+	Data        *flowDataConnectionConfigurationJSON        `json:"data,omitempty"`
+}
+
+type flowConditionalConnectionConfigurationJSON struct {
+	Condition *string `json:"condition,omitempty"`
+}
+
+type flowDataConnectionConfigurationJSON struct {
+	SourceOutput *string `json:"sourceOutput,omitempty"`
+	TargetInput  *string `json:"targetInput,omitempty"`
+}
+
+type flowNodeJSON struct {
+	Configuration *flowNodeConfigurationJSON `json:"configuration,omitempty"`
+	Inputs        []flowNodeInputJSON        `json:"inputs,omitempty"`
+	Name          *string                    `json:"name,omitempty"`
+	Outputs       []flowNodeOutputJSON       `json:"outputs,omitempty"`
+	Type          awstypes.FlowNodeType      `json:"type,omitempty"`
+}
+
+type flowNodeInputJSON struct {
+	Expression *string                     `json:"expression,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Type       awstypes.FlowNodeIODataType `json:"type,omitempty"`
+}
+
+type flowNodeOutputJSON struct {
+	Name *string                     `json:"name,omitempty"`
+	Type awstypes.FlowNodeIODataType `json:"type,omitempty"`
+}
+
+type flowNodeConfigurationJSON struct {
+	Agent          *agentFlowNodeConfigurationJSON          `json:"agent,omitempty"`
+	Collector      *struct{}                                `json:"collector,omitempty"`
+	Condition      *conditionFlowNodeConfigurationJSON      `json:"condition,omitempty"`
+	Input          *struct{}                                `json:"input,omitempty"`
+	Iterator       *struct{}                                `json:"iterator,omitempty"`
+	KnowledgeBase  *knowledgeBaseFlowNodeConfigurationJSON  `json:"knowledgeBase,omitempty"`
+	LambdaFunction *lambdaFunctionFlowNodeConfigurationJSON `json:"lambdaFunction,omitempty"`
+	Lex            *lexFlowNodeConfigurationJSON            `json:"lex,omitempty"`
+	Output         *struct{}                                `json:"output,omitempty"`
+	Prompt         *promptFlowNodeConfigurationJSON         `json:"prompt,omitempty"`
+	Retrieval      *flowNodeServiceConfigurationJSON        `json:"retrieval,omitempty"`
+	Storage        *flowNodeServiceConfigurationJSON        `json:"storage,omitempty"`
+}
+
+type agentFlowNodeConfigurationJSON struct {
+	AgentAliasARN *string `json:"agentAliasArn,omitempty"`
+}
+
+type conditionFlowNodeConfigurationJSON struct {
+	Conditions []flowConditionJSON `json:"conditions,omitempty"`
+}
+
+type flowConditionJSON struct {
+	Expression *string `json:"expression,omitempty"`
+	Name       *string `json:"name,omitempty"`
+}
+
+type knowledgeBaseFlowNodeConfigurationJSON struct {
+	KnowledgeBaseID *string `json:"knowledgeBaseId,omitempty"`
+	ModelID         *string `json:"modelId,omitempty"`
+}
+
+type lambdaFunctionFlowNodeConfigurationJSON struct {
+	LambdaARN *string `json:"lambdaArn,omitempty"`
+}
+
+type lexFlowNodeConfigurationJSON struct {
+	BotAliasARN *string `json:"botAliasArn,omitempty"`
+	LocaleID    *string `json:"localeId,omitempty"`
+}
+
+type promptFlowNodeConfigurationJSON struct {
+	SourceConfiguration *promptFlowNodeSourceConfigurationJSON `json:"sourceConfiguration,omitempty"`
+}
+
+type promptFlowNodeSourceConfigurationJSON struct {
+	Inline   *promptFlowNodeInlineConfigurationJSON   `json:"inline,omitempty"`
+	Resource *promptFlowNodeResourceConfigurationJSON `json:"resource,omitempty"`
+}
+
+type promptFlowNodeInlineConfigurationJSON struct {
+	InferenceConfiguration *promptInferenceConfigurationJSON `json:"inferenceConfiguration,omitempty"`
+	ModelID                *string                           `json:"modelId,omitempty"`
+	TemplateConfiguration  *promptTemplateConfigurationJSON  `json:"templateConfiguration,omitempty"`
+	TemplateType           awstypes.PromptTemplateType       `json:"templateType,omitempty"`
+}
+
+type promptFlowNodeResourceConfigurationJSON struct {
+	PromptARN *string `json:"promptArn,omitempty"`
+}
+
+type promptInferenceConfigurationJSON struct {
+	Text *promptModelInferenceConfigurationJSON `json:"text,omitempty"`
+}
+
+type promptModelInferenceConfigurationJSON struct {
+	MaxTokens     *int32   `json:"maxTokens,omitempty"`
+	StopSequences []string `json:"stopSequences,omitempty"`
+	Temperature   *float32 `json:"temperature,omitempty"`
+	TopK          *int32   `json:"topK,omitempty"`
+	TopP          *float32 `json:"topP,omitempty"`
+}
+
+type promptTemplateConfigurationJSON struct {
+	Text *textPromptTemplateConfigurationJSON `json:"text,omitempty"`
+}
+
+type textPromptTemplateConfigurationJSON struct {
+	InputVariables []promptInputVariableJSON `json:"inputVariables,omitempty"`
+	Text           *string                   `json:"text,omitempty"`
+}
+
+type promptInputVariableJSON struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type flowNodeServiceConfigurationJSON struct {
+	ServiceConfiguration *flowNodeServiceConfigurationMemberJSON `json:"serviceConfiguration,omitempty"`
+}
+
+type flowNodeServiceConfigurationMemberJSON struct {
+	S3 *flowNodeS3ConfigurationJSON `json:"s3,omitempty"`
+}
+
+type flowNodeS3ConfigurationJSON struct {
+	BucketName *string `json:"bucketName,omitempty"`
+}
+
+func expandFlowDefinitionJSON(s string) (*awstypes.FlowDefinition, error) {
+	var v flowDefinitionJSON
+
+	if err := tfjson.DecodeFromString(s, &v); err != nil {
+		return nil, fmt.Errorf("decoding flow definition JSON: %w", err)
+	}
+
+	apiObject := &awstypes.FlowDefinition{}
+
+	for _, v := range v.Connections {
+		connection := awstypes.FlowConnection{
+			Name:   v.Name,
+			Source: v.Source,
+			Target: v.Target,
+			Type:   v.Type,
+		}
+
+		if v := v.Configuration; v != nil {
+			switch {
+			case v.Conditional != nil:
+				connection.Configuration = &awstypes.FlowConnectionConfigurationMemberConditional{
+					Value: awstypes.FlowConditionalConnectionConfiguration{
+						Condition: v.Conditional.Condition,
+					},
+				}
+			case v.Data != nil:
+				connection.Configuration = &awstypes.FlowConnectionConfigurationMemberData{
+					Value: awstypes.FlowDataConnectionConfiguration{
+						SourceOutput: v.Data.SourceOutput,
+						TargetInput:  v.Data.TargetInput,
+					},
+				}
+			}
+		}
+
+		apiObject.Connections = append(apiObject.Connections, connection)
+	}
+
+	for _, v := range v.Nodes {
+		node := awstypes.FlowNode{
+			Name: v.Name,
+			Type: v.Type,
+		}
+
+		for _, v := range v.Inputs {
+			node.Inputs = append(node.Inputs, awstypes.FlowNodeInput{
+				Expression: v.Expression,
+				Name:       v.Name,
+				Type:       v.Type,
+			})
+		}
+
+		for _, v := range v.Outputs {
+			node.Outputs = append(node.Outputs, awstypes.FlowNodeOutput{
+				Name: v.Name,
+				Type: v.Type,
+			})
+		}
+
+		if v := v.Configuration; v != nil {
+			configuration, err := expandFlowNodeConfigurationJSON(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("flow node (%s): %w", aws.ToString(node.Name), err)
+			}
+
+			node.Configuration = configuration
+		}
+
+		apiObject.Nodes = append(apiObject.Nodes, node)
+	}
+
+	return apiObject, nil
+}
+
+func expandFlowNodeConfigurationJSON(v *flowNodeConfigurationJSON) (awstypes.FlowNodeConfiguration, error) {
+	switch {
+	case v.Agent != nil:
+		return &awstypes.FlowNodeConfigurationMemberAgent{
+			Value: awstypes.AgentFlowNodeConfiguration{
+				AgentAliasArn: v.Agent.AgentAliasARN,
+			},
+		}, nil
+
+	case v.Collector != nil:
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, nil
+
+	case v.Condition != nil:
+		apiObject := &awstypes.FlowNodeConfigurationMemberCondition{}
+
+		for _, v := range v.Condition.Conditions {
+			apiObject.Value.Conditions = append(apiObject.Value.Conditions, awstypes.FlowCondition{
+				Expression: v.Expression,
+				Name:       v.Name,
+			})
+		}
+
+		return apiObject, nil
+
+	case v.Input != nil:
+		return &awstypes.FlowNodeConfigurationMemberInput{}, nil
+
+	case v.Iterator != nil:
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, nil
+
+	case v.KnowledgeBase != nil:
+		return &awstypes.FlowNodeConfigurationMemberKnowledgeBase{
+			Value: awstypes.KnowledgeBaseFlowNodeConfiguration{
+				KnowledgeBaseId: v.KnowledgeBase.KnowledgeBaseID,
+				ModelId:         v.KnowledgeBase.ModelID,
+			},
+		}, nil
+
+	case v.LambdaFunction != nil:
+		return &awstypes.FlowNodeConfigurationMemberLambdaFunction{
+			Value: awstypes.LambdaFunctionFlowNodeConfiguration{
+				LambdaArn: v.LambdaFunction.LambdaARN,
+			},
+		}, nil
+
+	case v.Lex != nil:
+		return &awstypes.FlowNodeConfigurationMemberLex{
+			Value: awstypes.LexFlowNodeConfiguration{
+				BotAliasArn: v.Lex.BotAliasARN,
+				LocaleId:    v.Lex.LocaleID,
+			},
+		}, nil
+
+	case v.Output != nil:
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, nil
+
+	case v.Prompt != nil:
+		apiObject := &awstypes.FlowNodeConfigurationMemberPrompt{}
+
+		if v := v.Prompt.SourceConfiguration; v != nil {
+			switch {
+			case v.Inline != nil:
+				inline := awstypes.PromptFlowNodeInlineConfiguration{
+					ModelId:      v.Inline.ModelID,
+					TemplateType: v.Inline.TemplateType,
+				}
+
+				if v := v.Inline.InferenceConfiguration; v != nil && v.Text != nil {
+					inline.InferenceConfiguration = &awstypes.PromptInferenceConfigurationMemberText{
+						Value: awstypes.PromptModelInferenceConfiguration{
+							MaxTokens:     v.Text.MaxTokens,
+							StopSequences: v.Text.StopSequences,
+							Temperature:   v.Text.Temperature,
+							TopK:          v.Text.TopK,
+							TopP:          v.Text.TopP,
+						},
+					}
+				}
+
+				if v := v.Inline.TemplateConfiguration; v != nil && v.Text != nil {
+					text := awstypes.TextPromptTemplateConfiguration{
+						Text: v.Text.Text,
+					}
+
+					for _, v := range v.Text.InputVariables {
+						text.InputVariables = append(text.InputVariables, awstypes.PromptInputVariable{
+							Name: v.Name,
+						})
+					}
+
+					inline.TemplateConfiguration = &awstypes.PromptTemplateConfigurationMemberText{
+						Value: text,
+					}
+				}
+
+				apiObject.Value.SourceConfiguration = &awstypes.PromptFlowNodeSourceConfigurationMemberInline{
+					Value: inline,
+				}
+
+			case v.Resource != nil:
+				apiObject.Value.SourceConfiguration = &awstypes.PromptFlowNodeSourceConfigurationMemberResource{
+					Value: awstypes.PromptFlowNodeResourceConfiguration{
+						PromptArn: v.Resource.PromptARN,
+					},
+				}
+			}
+		}
+
+		return apiObject, nil
+
+	case v.Retrieval != nil:
+		apiObject := &awstypes.FlowNodeConfigurationMemberRetrieval{}
+
+		if v := v.Retrieval.ServiceConfiguration; v != nil && v.S3 != nil {
+			apiObject.Value.ServiceConfiguration = &awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{
+				Value: awstypes.RetrievalFlowNodeS3Configuration{
+					BucketName: v.S3.BucketName,
+				},
+			}
+		}
+
+		return apiObject, nil
+
+	case v.Storage != nil:
+		apiObject := &awstypes.FlowNodeConfigurationMemberStorage{}
+
+		if v := v.Storage.ServiceConfiguration; v != nil && v.S3 != nil {
+			apiObject.Value.ServiceConfiguration = &awstypes.StorageFlowNodeServiceConfigurationMemberS3{
+				Value: awstypes.StorageFlowNodeS3Configuration{
+					BucketName: v.S3.BucketName,
+				},
+			}
+		}
+
+		return apiObject, nil
+	}
+
+	return nil, errors.New("unsupported configuration")
+}
+
+func flattenFlowDefinitionJSON(apiObject *awstypes.FlowDefinition) (string, error) {
+	var v flowDefinitionJSON
+
+	if apiObject != nil {
+		for _, apiObject := range apiObject.Connections {
+			connection := flowConnectionJSON{
+				Name:   apiObject.Name,
+				Source: apiObject.Source,
+				Target: apiObject.Target,
+				Type:   apiObject.Type,
+			}
+
+			switch apiObject := apiObject.Configuration.(type) {
+			case *awstypes.FlowConnectionConfigurationMemberConditional:
+				connection.Configuration = &flowConnectionConfigurationJSON{
+					Conditional: &flowConditionalConnectionConfigurationJSON{
+						Condition: apiObject.Value.Condition,
+					},
+				}
+			case *awstypes.FlowConnectionConfigurationMemberData:
+				connection.Configuration = &flowConnectionConfigurationJSON{
+					Data: &flowDataConnectionConfigurationJSON{
+						SourceOutput: apiObject.Value.SourceOutput,
+						TargetInput:  apiObject.Value.TargetInput,
+					},
+				}
+			}
+
+			v.Connections = append(v.Connections, connection)
+		}
+
+		for _, apiObject := range apiObject.Nodes {
+			node := flowNodeJSON{
+				Configuration: flattenFlowNodeConfigurationJSON(apiObject.Configuration),
+				Name:          apiObject.Name,
+				Type:          apiObject.Type,
+			}
+
+			for _, apiObject := range apiObject.Inputs {
+				node.Inputs = append(node.Inputs, flowNodeInputJSON{
+					Expression: apiObject.Expression,
+					Name:       apiObject.Name,
+					Type:       apiObject.Type,
+				})
+			}
+
+			for _, apiObject := range apiObject.Outputs {
+				node.Outputs = append(node.Outputs, flowNodeOutputJSON{
+					Name: apiObject.Name,
+					Type: apiObject.Type,
+				})
+			}
+
+			v.Nodes = append(v.Nodes, node)
+		}
+	}
+
+	return tfjson.EncodeToString(v)
+}
+
+func flattenFlowNodeConfigurationJSON(apiObject awstypes.FlowNodeConfiguration) *flowNodeConfigurationJSON {
+	switch apiObject := apiObject.(type) {
+	case *awstypes.FlowNodeConfigurationMemberAgent:
+		return &flowNodeConfigurationJSON{
+			Agent: &agentFlowNodeConfigurationJSON{
+				AgentAliasARN: apiObject.Value.AgentAliasArn,
+			},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberCollector:
+		return &flowNodeConfigurationJSON{
+			Collector: &struct{}{},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberCondition:
+		condition := &conditionFlowNodeConfigurationJSON{}
+
+		for _, apiObject := range apiObject.Value.Conditions {
+			condition.Conditions = append(condition.Conditions, flowConditionJSON{
+				Expression: apiObject.Expression,
+				Name:       apiObject.Name,
+			})
+		}
+
+		return &flowNodeConfigurationJSON{
+			Condition: condition,
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberInput:
+		return &flowNodeConfigurationJSON{
+			Input: &struct{}{},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberIterator:
+		return &flowNodeConfigurationJSON{
+			Iterator: &struct{}{},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		return &flowNodeConfigurationJSON{
+			KnowledgeBase: &knowledgeBaseFlowNodeConfigurationJSON{
+				KnowledgeBaseID: apiObject.Value.KnowledgeBaseId,
+				ModelID:         apiObject.Value.ModelId,
+			},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		return &flowNodeConfigurationJSON{
+			LambdaFunction: &lambdaFunctionFlowNodeConfigurationJSON{
+				LambdaARN: apiObject.Value.LambdaArn,
+			},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberLex:
+		return &flowNodeConfigurationJSON{
+			Lex: &lexFlowNodeConfigurationJSON{
+				BotAliasARN: apiObject.Value.BotAliasArn,
+				LocaleID:    apiObject.Value.LocaleId,
+			},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberOutput:
+		return &flowNodeConfigurationJSON{
+			Output: &struct{}{},
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberPrompt:
+		prompt := &promptFlowNodeConfigurationJSON{}
+
+		switch apiObject := apiObject.Value.SourceConfiguration.(type) {
+		case *awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+			inline := &promptFlowNodeInlineConfigurationJSON{
+				ModelID:      apiObject.Value.ModelId,
+				TemplateType: apiObject.Value.TemplateType,
+			}
+
+			if apiObject, ok := apiObject.Value.InferenceConfiguration.(*awstypes.PromptInferenceConfigurationMemberText); ok {
+				inline.InferenceConfiguration = &promptInferenceConfigurationJSON{
+					Text: &promptModelInferenceConfigurationJSON{
+						MaxTokens:     apiObject.Value.MaxTokens,
+						StopSequences: apiObject.Value.StopSequences,
+						Temperature:   apiObject.Value.Temperature,
+						TopK:          apiObject.Value.TopK,
+						TopP:          apiObject.Value.TopP,
+					},
+				}
+			}
+
+			if apiObject, ok := apiObject.Value.TemplateConfiguration.(*awstypes.PromptTemplateConfigurationMemberText); ok {
+				text := &textPromptTemplateConfigurationJSON{
+					Text: apiObject.Value.Text,
+				}
+
+				for _, apiObject := range apiObject.Value.InputVariables {
+					text.InputVariables = append(text.InputVariables, promptInputVariableJSON{
+						Name: apiObject.Name,
+					})
+				}
+
+				inline.TemplateConfiguration = &promptTemplateConfigurationJSON{
+					Text: text,
+				}
+			}
+
+			prompt.SourceConfiguration = &promptFlowNodeSourceConfigurationJSON{
+				Inline: inline,
+			}
+
+		case *awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+			prompt.SourceConfiguration = &promptFlowNodeSourceConfigurationJSON{
+				Resource: &promptFlowNodeResourceConfigurationJSON{
+					PromptARN: apiObject.Value.PromptArn,
+				},
+			}
+		}
+
+		return &flowNodeConfigurationJSON{
+			Prompt: prompt,
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberRetrieval:
+		retrieval := &flowNodeServiceConfigurationJSON{}
+
+		if apiObject, ok := apiObject.Value.ServiceConfiguration.(*awstypes.RetrievalFlowNodeServiceConfigurationMemberS3); ok {
+			retrieval.ServiceConfiguration = &flowNodeServiceConfigurationMemberJSON{
+				S3: &flowNodeS3ConfigurationJSON{
+					BucketName: apiObject.Value.BucketName,
+				},
+			}
+		}
+
+		return &flowNodeConfigurationJSON{
+			Retrieval: retrieval,
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberStorage:
+		storage := &flowNodeServiceConfigurationJSON{}
+
+		if apiObject, ok := apiObject.Value.ServiceConfiguration.(*awstypes.StorageFlowNodeServiceConfigurationMemberS3); ok {
+			storage.ServiceConfiguration = &flowNodeServiceConfigurationMemberJSON{
+				S3: &flowNodeS3ConfigurationJSON{
+					BucketName: apiObject.Value.BucketName,
+				},
+			}
+		}
+
+		return &flowNodeConfigurationJSON{
+			Storage: storage,
+		}
+	}
+
+	return nil
+}
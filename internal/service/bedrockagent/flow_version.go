@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow Version")
+func newFlowVersionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowVersionResource{}
+
+	return r, nil
+}
+
+type flowVersionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (*flowVersionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow_version"
+}
+
+func (r *flowVersionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"flow_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *flowVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowVersionInput{
+		ClientToken:    aws.String(id.UniqueId()),
+		Description:    fwflex.StringFromFramework(ctx, data.Description),
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.FlowID),
+	}
+
+	output, err := conn.CreateFlowVersion(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s) Version", data.FlowID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(output.CreatedAt)
+	data.ExecutionRoleARN = fwtypes.ARNValue(aws.ToString(output.ExecutionRoleArn))
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowVersionByTwoPartKey(ctx, conn, data.FlowID.ValueString(), data.Version.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API's Id is the flow ID; restore the resource ID.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowVersionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlowVersion(ctx, &bedrockagent.DeleteFlowVersionInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.FlowID),
+		FlowVersion:    fwflex.StringFromFramework(ctx, data.Version),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findFlowVersionByTwoPartKey(ctx context.Context, conn *bedrockagent.Client, flowID, version string) (*bedrockagent.GetFlowVersionOutput, error) {
+	input := &bedrockagent.GetFlowVersionInput{
+		FlowIdentifier: aws.String(flowID),
+		FlowVersion:    aws.String(version),
+	}
+
+	output, err := conn.GetFlowVersion(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type flowVersionResourceModel struct {
+	ARN              types.String                            `tfsdk:"arn"`
+	CreatedAt        timetypes.RFC3339                       `tfsdk:"created_at"`
+	Description      types.String                            `tfsdk:"description"`
+	ExecutionRoleARN fwtypes.ARN                             `tfsdk:"execution_role_arn"`
+	FlowID           types.String                            `tfsdk:"flow_id"`
+	ID               types.String                            `tfsdk:"id"`
+	Name             types.String                            `tfsdk:"name"`
+	Status           fwtypes.StringEnum[awstypes.FlowStatus] `tfsdk:"status"`
+	Version          types.String                            `tfsdk:"version"`
+}
+
+const (
+	flowVersionResourceIDPartCount = 2
+)
+
+func (m *flowVersionResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, flowVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.FlowID = types.StringValue(parts[0])
+	m.Version = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *flowVersionResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.FlowID.ValueString(), m.Version.ValueString()}, flowVersionResourceIDPartCount, false)))
+}
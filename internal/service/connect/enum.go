@@ -20,6 +20,9 @@ const (
 	// MaxResults Valid Range: Minimum value of 1. Maximum value of 1000
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListHoursOfOperations.html
 	ListHoursOfOperationsMaxResults = 60
+	// ListIntegrationAssociationsMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListIntegrationAssociations.html
+	ListIntegrationAssociationsMaxResults = 60
 	// ListLambdaFunctionsMaxResults Valid Range: Minimum value of 1. Maximum value of 25.
 	//https://docs.aws.amazon.com/connect/latest/APIReference/API_ListLambdaFunctions.html
 	ListLambdaFunctionsMaxResults = 25
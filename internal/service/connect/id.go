@@ -9,6 +9,7 @@ import (
 )
 
 const botV1AssociationIDSeparator = ":"
+const integrationAssociationIDSeparator = ","
 const lambdaFunctionAssociationIDSeparator = ","
 
 func BotV1AssociationParseResourceID(id string) (string, string, string, error) {
@@ -28,6 +29,24 @@ func BotV1AssociationCreateResourceID(instanceID string, botName string, region
 	return id
 }
 
+func IntegrationAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, integrationAssociationIDSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "",
+			fmt.Errorf("unexpected format for ID (%q), expected instanceID"+integrationAssociationIDSeparator+
+				"integrationAssociationID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func IntegrationAssociationCreateResourceID(instanceID string, integrationAssociationID string) string {
+	parts := []string{instanceID, integrationAssociationID}
+	id := strings.Join(parts, integrationAssociationIDSeparator)
+
+	return id
+}
+
 func LambdaFunctionAssociationParseResourceID(id string) (string, string, error) {
 	parts := strings.SplitN(id, lambdaFunctionAssociationIDSeparator, 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
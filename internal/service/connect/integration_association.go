@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_integration_association", name="Integration Association")
+// @Tags(identifierAttribute="arn")
+func ResourceIntegrationAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIntegrationAssociationCreate,
+		ReadWithoutTimeout:   resourceIntegrationAssociationRead,
+		UpdateWithoutTimeout: resourceIntegrationAssociationUpdate,
+		DeleteWithoutTimeout: resourceIntegrationAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"integration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"integration_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"integration_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(connect.IntegrationType_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceIntegrationAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	input := &connect.CreateIntegrationAssociationInput{
+		InstanceId:      aws.String(instanceID),
+		IntegrationArn:  aws.String(d.Get("integration_arn").(string)),
+		IntegrationType: aws.String(d.Get("integration_type").(string)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateIntegrationAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Integration Association (%s): %s", instanceID, err)
+	}
+
+	d.SetId(IntegrationAssociationCreateResourceID(instanceID, aws.StringValue(output.IntegrationAssociationId)))
+
+	return append(diags, resourceIntegrationAssociationRead(ctx, d, meta)...)
+}
+
+func resourceIntegrationAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, integrationAssociationID, err := IntegrationAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	association, err := FindIntegrationAssociationByTwoPartKey(ctx, conn, instanceID, integrationAssociationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Integration Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, association.IntegrationAssociationArn)
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set("integration_arn", association.IntegrationArn)
+	d.Set("integration_association_id", association.IntegrationAssociationId)
+	d.Set("integration_type", association.IntegrationType)
+
+	return diags
+}
+
+func resourceIntegrationAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceIntegrationAssociationRead(ctx, d, meta)...)
+}
+
+func resourceIntegrationAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID, integrationAssociationID, err := IntegrationAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Integration Association: %s", d.Id())
+	_, err = conn.DeleteIntegrationAssociationWithContext(ctx, &connect.DeleteIntegrationAssociationInput{
+		InstanceId:               aws.String(instanceID),
+		IntegrationAssociationId: aws.String(integrationAssociationID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Integration Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -131,6 +131,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceInstanceStorageConfig,
 			TypeName: "aws_connect_instance_storage_config",
 		},
+		{
+			Factory:  ResourceIntegrationAssociation,
+			TypeName: "aws_connect_integration_association",
+			Name:     "Integration Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceLambdaFunctionAssociation,
 			TypeName: "aws_connect_lambda_function_association",
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Domain")
+// @Tags(identifierAttribute="arn")
+func newDomainResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &domainResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type domainResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[domainResourceModel]
+	framework.WithTimeouts
+}
+
+func (*domainResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_connectcases_domain"
+}
+
+func (r *domainResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"domain_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DomainStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^.*[\S]$`), "must not end with a whitespace character"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *domainResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &connectcases.CreateDomainInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateDomain(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Domain (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DomainARN = fwflex.StringToFramework(ctx, output.DomainArn)
+	data.DomainID = fwflex.StringToFramework(ctx, output.DomainId)
+
+	domain, err := waitDomainCreated(ctx, conn, data.DomainID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.DomainID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Connect Cases Domain (%s) create", data.DomainID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DomainStatus = fwtypes.StringEnumValue(domain.DomainStatus)
+
+	// CreateDomain does not accept tags.
+	if err := createTags(ctx, conn, data.DomainARN.ValueString(), getTagsIn(ctx)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.DomainID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Domain (%s) tags", data.DomainID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *domainResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findDomainByID(ctx, conn, data.DomainID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Domain (%s)", data.DomainID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *domainResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data domainResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	_, err := conn.DeleteDomain(ctx, &connectcases.DeleteDomainInput{
+		DomainId: aws.String(data.DomainID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Domain (%s)", data.DomainID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDomainDeleted(ctx, conn, data.DomainID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Connect Cases Domain (%s) delete", data.DomainID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *domainResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDomainByID(ctx context.Context, conn *connectcases.Client, id string) (*connectcases.GetDomainOutput, error) {
+	input := &connectcases.GetDomainInput{
+		DomainId: aws.String(id),
+	}
+
+	output, err := conn.GetDomain(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDomain(ctx context.Context, conn *connectcases.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDomainByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DomainStatus), nil
+	}
+}
+
+func waitDomainCreated(ctx context.Context, conn *connectcases.Client, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainStatusCreationInProgress),
+		Target:  enum.Slice(awstypes.DomainStatusActive),
+		Refresh: statusDomain(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDomainDeleted(ctx context.Context, conn *connectcases.Client, id string, timeout time.Duration) (*connectcases.GetDomainOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DomainStatusActive, awstypes.DomainStatusCreationFailed),
+		Target:  []string{},
+		Refresh: statusDomain(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*connectcases.GetDomainOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type domainResourceModel struct {
+	DomainARN    types.String                              `tfsdk:"arn"`
+	DomainID     types.String                              `tfsdk:"id"`
+	DomainStatus fwtypes.StringEnum[awstypes.DomainStatus] `tfsdk:"domain_status"`
+	Name         types.String                              `tfsdk:"name"`
+	Tags         types.Map                                 `tfsdk:"tags"`
+	TagsAll      types.Map                                 `tfsdk:"tags_all"`
+	Timeouts     timeouts.Value                            `tfsdk:"timeouts"`
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Field")
+// @Tags(identifierAttribute="arn")
+func newFieldResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &fieldResource{}, nil
+}
+
+type fieldResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*fieldResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_connectcases_field"
+}
+
+func (r *fieldResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 255),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_id":   framework.IDAttribute(),
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldNamespace](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FieldType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"option": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[fieldOptionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 100),
+							},
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *fieldResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &connectcases.CreateFieldInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateField(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Field (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.FieldARN = fwflex.StringToFramework(ctx, output.FieldArn)
+	data.FieldID = fwflex.StringToFramework(ctx, output.FieldId)
+	data.setID()
+
+	if !data.Options.IsNull() {
+		options, diags := expandFieldOptions(ctx, data.Options, true)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := putFieldOptions(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString(), options); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Connect Cases Field (%s) options", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	field, err := findFieldByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Namespace = fwtypes.StringEnumValue(field.Namespace)
+
+	// CreateField does not accept tags.
+	if err := createTags(ctx, conn, data.FieldARN.ValueString(), getTagsIn(ctx)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Field (%s) tags", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findFieldByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	options, err := findActiveFieldOptionsByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.FieldID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Field (%s) options", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if len(options) > 0 {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, options, &data.Options)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		data.Options = fwtypes.NewSetNestedObjectValueOfNull[fieldOptionModel](ctx)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *fieldResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		input := &connectcases.UpdateFieldInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateField(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Field (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Options.Equal(old.Options) {
+		newOptions, diags := expandFieldOptions(ctx, new.Options, true)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldOptions, diags := expandFieldOptions(ctx, old.Options, false)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Field options cannot be deleted, only deactivated.
+		values := make(map[string]struct{})
+		for _, v := range newOptions {
+			values[aws.ToString(v.Value)] = struct{}{}
+		}
+		options := newOptions
+		for _, v := range oldOptions {
+			if _, ok := values[aws.ToString(v.Value)]; !ok {
+				options = append(options, v)
+			}
+		}
+
+		if err := putFieldOptions(ctx, conn, new.DomainID.ValueString(), new.FieldID.ValueString(), options); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Connect Cases Field (%s) options", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *fieldResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data fieldResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	_, err := conn.DeleteField(ctx, &connectcases.DeleteFieldInput{
+		DomainId: aws.String(data.DomainID.ValueString()),
+		FieldId:  aws.String(data.FieldID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Field (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *fieldResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findFieldByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) (*awstypes.GetFieldResponse, error) {
+	input := &connectcases.BatchGetFieldInput{
+		DomainId: aws.String(domainID),
+		Fields: []awstypes.FieldIdentifier{{
+			Id: aws.String(fieldID),
+		}},
+	}
+
+	output, err := conn.BatchGetField(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Errors {
+		if aws.ToString(v.Id) == fieldID {
+			return nil, &retry.NotFoundError{
+				Message:     aws.ToString(v.Message),
+				LastRequest: input,
+			}
+		}
+	}
+
+	field, err := tfresource.AssertSingleValueResult(output.Fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field.Deleted {
+		return nil, &retry.NotFoundError{
+			Message:     "deleted",
+			LastRequest: input,
+		}
+	}
+
+	return field, nil
+}
+
+func findActiveFieldOptionsByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, fieldID string) ([]awstypes.FieldOption, error) {
+	input := &connectcases.ListFieldOptionsInput{
+		DomainId: aws.String(domainID),
+		FieldId:  aws.String(fieldID),
+	}
+	var output []awstypes.FieldOption
+
+	pages := connectcases.NewListFieldOptionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Options {
+			if aws.ToBool(v.Active) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func putFieldOptions(ctx context.Context, conn *connectcases.Client, domainID, fieldID string, options []awstypes.FieldOption) error {
+	input := &connectcases.BatchPutFieldOptionsInput{
+		DomainId: aws.String(domainID),
+		FieldId:  aws.String(fieldID),
+		Options:  options,
+	}
+
+	output, err := conn.BatchPutFieldOptions(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil && len(output.Errors) > 0 {
+		v := output.Errors[0]
+		return fmt.Errorf("%s: %s (%s)", aws.ToString(v.Value), aws.ToString(v.Message), aws.ToString(v.ErrorCode))
+	}
+
+	return nil
+}
+
+func expandFieldOptions(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[fieldOptionModel], active bool) ([]awstypes.FieldOption, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var options []awstypes.FieldOption
+
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return options, diags
+	}
+
+	diags.Append(fwflex.Expand(ctx, tfSet, &options)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i := range options {
+		options[i].Active = aws.Bool(active)
+	}
+
+	return options, diags
+}
+
+type fieldResourceModel struct {
+	Description types.String                                     `tfsdk:"description"`
+	DomainID    types.String                                     `tfsdk:"domain_id"`
+	FieldARN    types.String                                     `tfsdk:"arn"`
+	FieldID     types.String                                     `tfsdk:"field_id"`
+	ID          types.String                                     `tfsdk:"id"`
+	Name        types.String                                     `tfsdk:"name"`
+	Namespace   fwtypes.StringEnum[awstypes.FieldNamespace]      `tfsdk:"namespace"`
+	Options     fwtypes.SetNestedObjectValueOf[fieldOptionModel] `tfsdk:"option"`
+	Tags        types.Map                                        `tfsdk:"tags"`
+	TagsAll     types.Map                                        `tfsdk:"tags_all"`
+	Type        fwtypes.StringEnum[awstypes.FieldType]           `tfsdk:"type"`
+}
+
+const (
+	fieldResourceIDPartCount = 2
+)
+
+func (m *fieldResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), fieldResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.DomainID = types.StringValue(parts[0])
+	m.FieldID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *fieldResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.DomainID.ValueString(), m.FieldID.ValueString()}, fieldResourceIDPartCount, false)))
+}
+
+type fieldOptionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccField_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_field.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "field_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamespace, "Custom"),
+					resource.TestCheckResourceAttr(resourceName, "option.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "Text"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccField_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_field.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceField, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccField_options(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_field.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_options2(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "option.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						names.AttrName:  "Low",
+						names.AttrValue: "low",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						names.AttrName:  "High",
+						names.AttrValue: "high",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "SingleSelect"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFieldConfig_options1(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "option.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "option.*", map[string]string{
+						names.AttrName:  "Medium",
+						names.AttrValue: "medium",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccField_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_field.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFieldDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldConfig_description(rName, rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				Config: testAccFieldConfig_description(rName, rNameUpdated, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFieldExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFieldDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_field" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindFieldByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["field_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Field %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFieldExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient(ctx)
+
+		_, err := tfconnectcases.FindFieldByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["field_id"])
+
+		return err
+	}
+}
+
+func testAccFieldConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccFieldConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+  type      = "Text"
+}
+`, rName))
+}
+
+func testAccFieldConfig_description(rName, fieldName, description string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id   = aws_connectcases_domain.test.id
+  name        = %[1]q
+  description = %[2]q
+  type        = "Text"
+}
+`, fieldName, description))
+}
+
+func testAccFieldConfig_options2(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+  type      = "SingleSelect"
+
+  option {
+    name  = "Low"
+    value = "low"
+  }
+
+  option {
+    name  = "High"
+    value = "high"
+  }
+}
+`, rName))
+}
+
+func testAccFieldConfig_options1(rName string) string {
+	return acctest.ConfigCompose(testAccFieldConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+  type      = "SingleSelect"
+
+  option {
+    name  = "Medium"
+    value = "medium"
+  }
+}
+`, rName))
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connectcases"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connectcases/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template")
+// @Tags(identifierAttribute="arn")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &templateResource{}, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*templateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_connectcases_template"
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 255),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TemplateStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"template_id":     framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"layout_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[layoutConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"default_layout": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"required_field": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requiredFieldModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &connectcases.CreateTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Connect Cases Template (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TemplateARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.TemplateID = fwflex.StringToFramework(ctx, output.TemplateId)
+	data.setID()
+
+	template, err := findTemplateByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.TemplateID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(template.Status)
+
+	// CreateTemplate does not accept tags.
+	if err := createTags(ctx, conn, data.TemplateARN.ValueString(), getTagsIn(ctx)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("setting Connect Cases Template (%s) tags", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	output, err := findTemplateByTwoPartKey(ctx, conn, data.DomainID.ValueString(), data.TemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Connect Cases Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.LayoutConfiguration.Equal(old.LayoutConfiguration) ||
+		!new.Name.Equal(old.Name) ||
+		!new.RequiredFields.Equal(old.RequiredFields) ||
+		!new.Status.Equal(old.Status) {
+		input := &connectcases.UpdateTemplateInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// An empty list removes all required fields.
+		if input.RequiredFields == nil {
+			input.RequiredFields = []awstypes.RequiredField{}
+		}
+
+		_, err := conn.UpdateTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Connect Cases Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ConnectCasesClient(ctx)
+
+	_, err := conn.DeleteTemplate(ctx, &connectcases.DeleteTemplateInput{
+		DomainId:   aws.String(data.DomainID.ValueString()),
+		TemplateId: aws.String(data.TemplateID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Connect Cases Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *templateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTemplateByTwoPartKey(ctx context.Context, conn *connectcases.Client, domainID, templateID string) (*connectcases.GetTemplateOutput, error) {
+	input := &connectcases.GetTemplateInput{
+		DomainId:   aws.String(domainID),
+		TemplateId: aws.String(templateID),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Deleted {
+		return nil, &retry.NotFoundError{
+			Message:     "deleted",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+type templateResourceModel struct {
+	Description         types.String                                              `tfsdk:"description"`
+	DomainID            types.String                                              `tfsdk:"domain_id"`
+	ID                  types.String                                              `tfsdk:"id"`
+	LayoutConfiguration fwtypes.ListNestedObjectValueOf[layoutConfigurationModel] `tfsdk:"layout_configuration"`
+	Name                types.String                                              `tfsdk:"name"`
+	RequiredFields      fwtypes.ListNestedObjectValueOf[requiredFieldModel]       `tfsdk:"required_field"`
+	Status              fwtypes.StringEnum[awstypes.TemplateStatus]               `tfsdk:"status"`
+	Tags                types.Map                                                 `tfsdk:"tags"`
+	TagsAll             types.Map                                                 `tfsdk:"tags_all"`
+	TemplateARN         types.String                                              `tfsdk:"arn"`
+	TemplateID          types.String                                              `tfsdk:"template_id"`
+}
+
+const (
+	templateResourceIDPartCount = 2
+)
+
+func (m *templateResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), templateResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.DomainID = types.StringValue(parts[0])
+	m.TemplateID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *templateResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.DomainID.ValueString(), m.TemplateID.ValueString()}, templateResourceIDPartCount, false)))
+}
+
+type layoutConfigurationModel struct {
+	DefaultLayout types.String `tfsdk:"default_layout"`
+}
+
+type requiredFieldModel struct {
+	FieldID types.String `tfsdk:"field_id"`
+}
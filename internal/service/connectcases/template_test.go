@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connectcases_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnectcases "github.com/hashicorp/terraform-provider-aws/internal/service/connectcases"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_connectcases_domain.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "layout_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "required_field.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+					resource.TestCheckResourceAttrSet(resourceName, "template_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfconnectcases.ResourceTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_connectcases_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectCasesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Active"),
+				),
+			},
+			{
+				Config: testAccTemplateConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "example"),
+					resource.TestCheckResourceAttr(resourceName, "layout_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "layout_configuration.0.default_layout", "aws_connectcases_layout.test", "layout_id"),
+					resource.TestCheckResourceAttr(resourceName, "required_field.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "required_field.0.field_id", "aws_connectcases_field.test", "field_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Inactive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connectcases_template" {
+				continue
+			}
+
+			_, err := tfconnectcases.FindTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Cases Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectCasesClient(ctx)
+
+		_, err := tfconnectcases.FindTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["domain_id"], rs.Primary.Attributes["template_id"])
+
+		return err
+	}
+}
+
+func testAccTemplateConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_connectcases_domain" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccTemplateConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_template" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+}
+`, rName))
+}
+
+func testAccTemplateConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_base(rName), fmt.Sprintf(`
+resource "aws_connectcases_field" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+  type      = "Text"
+}
+
+resource "aws_connectcases_layout" "test" {
+  domain_id = aws_connectcases_domain.test.id
+  name      = %[1]q
+
+  content {
+    basic {
+      top_panel {
+        section {
+          field_group {
+            field {
+              id = aws_connectcases_field.test.field_id
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_connectcases_template" "test" {
+  domain_id   = aws_connectcases_domain.test.id
+  name        = %[1]q
+  description = "example"
+  status      = "Inactive"
+
+  layout_configuration {
+    default_layout = aws_connectcases_layout.test.layout_id
+  }
+
+  required_field {
+    field_id = aws_connectcases_field.test.field_id
+  }
+}
+`, rName))
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_dataset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("dataset/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "input.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "input.0.s3_input_definition.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "input.0.s3_input_definition.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSource, "S3"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewDataset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_dataset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceDataset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewDataset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_dataset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewDataset_formatOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_dataset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_formatOptions(rName, ",", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "format_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.header_row", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_formatOptions(rName, ";", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "format_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.delimiter", ";"),
+					resource.TestCheckResourceAttr(resourceName, "format_options.0.csv.0.header_row", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_dataset" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Dataset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		_, err := tfdatabrew.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDatasetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "input/data.csv"
+  content = "id,name\n1,alpha\n2,beta\n"
+}
+`, rName)
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDatasetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDatasetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccDatasetConfig_formatOptions(rName, delimiter string, headerRow bool) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_dataset" "test" {
+  name   = %[1]q
+  format = "CSV"
+
+  format_options {
+    csv {
+      delimiter  = %[2]q
+      header_row = %[3]t
+    }
+  }
+
+  input {
+    s3_input_definition {
+      bucket = aws_s3_object.test.bucket
+      key    = aws_s3_object.test.key
+    }
+  }
+}
+`, rName, delimiter, headerRow))
+}
@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Profile Job")
+// @Tags(identifierAttribute="arn")
+func newProfileJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileJobResource{}, nil
+}
+
+type profileJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*profileJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_databrew_profile_job"
+}
+
+func (r *profileJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	statisticsConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[statisticsConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"included_statistics": schema.ListAttribute{
+						CustomType:  fwtypes.ListOfStringType,
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"override": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[statisticOverrideModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								names.AttrParameters: schema.MapAttribute{
+									CustomType:  fwtypes.MapOfStringType,
+									ElementType: types.StringType,
+									Required:    true,
+								},
+								"statistic": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	columnSelectorBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: schema.StringAttribute{
+						Optional: true,
+					},
+					"regex": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"encryption_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"log_subscription": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_capacity": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 240),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"column_statistics_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[columnStatisticsConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"selector":   columnSelectorBlock(),
+									"statistics": statisticsConfigurationBlock(),
+								},
+							},
+						},
+						"dataset_statistics_configuration": statisticsConfigurationBlock(),
+						"entity_detector_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityDetectorConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_types": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"allowed_statistics": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[allowedStatisticsModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"statistics": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"profile_column": columnSelectorBlock(),
+					},
+				},
+			},
+			"job_sample": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobSampleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrMode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SampleMode](),
+							Optional:   true,
+						},
+						names.AttrSize: schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"output_location": s3LocationBlock(ctx),
+			"validation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ruleset_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"validation_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	input := &databrew.CreateProfileJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateProfileJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Profile Job (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	output, err := findJobByTwoPartKey(ctx, conn, data.ID.ValueString(), awstypes.JobTypeProfile)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	output, err := findJobByTwoPartKey(ctx, conn, data.ID.ValueString(), awstypes.JobTypeProfile)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new profileJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	if !new.Configuration.Equal(old.Configuration) ||
+		!new.EncryptionKeyARN.Equal(old.EncryptionKeyARN) ||
+		!new.EncryptionMode.Equal(old.EncryptionMode) ||
+		!new.JobSample.Equal(old.JobSample) ||
+		!new.LogSubscription.Equal(old.LogSubscription) ||
+		!new.MaxCapacity.Equal(old.MaxCapacity) ||
+		!new.MaxRetries.Equal(old.MaxRetries) ||
+		!new.OutputLocation.Equal(old.OutputLocation) ||
+		!new.RoleARN.Equal(old.RoleARN) ||
+		!new.Timeout.Equal(old.Timeout) ||
+		!new.ValidationConfigurations.Equal(old.ValidationConfigurations) {
+		input := &databrew.UpdateProfileJobInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateProfileJob(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Profile Job (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, new.ID.ValueString(), awstypes.JobTypeProfile)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Profile Job (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(new.flatten(ctx, output)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	_, err := conn.DeleteJob(ctx, &databrew.DeleteJobInput{
+		Name: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Profile Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *profileJobResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type profileJobResourceModel struct {
+	Configuration            fwtypes.ListNestedObjectValueOf[profileConfigurationModel]    `tfsdk:"configuration"`
+	DatasetName              types.String                                                  `tfsdk:"dataset_name"`
+	EncryptionKeyARN         fwtypes.ARN                                                   `tfsdk:"encryption_key_arn"`
+	EncryptionMode           fwtypes.StringEnum[awstypes.EncryptionMode]                   `tfsdk:"encryption_mode"`
+	ID                       types.String                                                  `tfsdk:"id"`
+	JobSample                fwtypes.ListNestedObjectValueOf[jobSampleModel]               `tfsdk:"job_sample"`
+	LogSubscription          fwtypes.StringEnum[awstypes.LogSubscription]                  `tfsdk:"log_subscription"`
+	MaxCapacity              types.Int64                                                   `tfsdk:"max_capacity"`
+	MaxRetries               types.Int64                                                   `tfsdk:"max_retries"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	OutputLocation           fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"output_location"`
+	ResourceARN              types.String                                                  `tfsdk:"arn"`
+	RoleARN                  fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                     types.Map                                                     `tfsdk:"tags"`
+	TagsAll                  types.Map                                                     `tfsdk:"tags_all"`
+	Timeout                  types.Int64                                                   `tfsdk:"timeout"`
+	ValidationConfigurations fwtypes.ListNestedObjectValueOf[validationConfigurationModel] `tfsdk:"validation_configuration"`
+}
+
+func (model *profileJobResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *profileJobResourceModel) setID() {
+	model.ID = model.Name
+}
+
+func (model *profileJobResourceModel) flatten(ctx context.Context, output *databrew.DescribeJobOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, model)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// DescribeJob returns the profile configuration in a differently named field.
+	diags.Append(fwflex.Flatten(ctx, output.ProfileConfiguration, &model.Configuration)...)
+
+	return diags
+}
+
+type profileConfigurationModel struct {
+	ColumnStatisticsConfigurations fwtypes.ListNestedObjectValueOf[columnStatisticsConfigurationModel] `tfsdk:"column_statistics_configuration"`
+	DatasetStatisticsConfiguration fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel]       `tfsdk:"dataset_statistics_configuration"`
+	EntityDetectorConfiguration    fwtypes.ListNestedObjectValueOf[entityDetectorConfigurationModel]   `tfsdk:"entity_detector_configuration"`
+	ProfileColumns                 fwtypes.ListNestedObjectValueOf[columnSelectorModel]                `tfsdk:"profile_column"`
+}
+
+type columnStatisticsConfigurationModel struct {
+	Selectors  fwtypes.ListNestedObjectValueOf[columnSelectorModel]          `tfsdk:"selector"`
+	Statistics fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel] `tfsdk:"statistics"`
+}
+
+type columnSelectorModel struct {
+	Name  types.String `tfsdk:"name"`
+	Regex types.String `tfsdk:"regex"`
+}
+
+type statisticsConfigurationModel struct {
+	IncludedStatistics fwtypes.ListValueOf[types.String]                       `tfsdk:"included_statistics"`
+	Overrides          fwtypes.ListNestedObjectValueOf[statisticOverrideModel] `tfsdk:"override"`
+}
+
+type statisticOverrideModel struct {
+	Parameters fwtypes.MapValueOf[types.String] `tfsdk:"parameters"`
+	Statistic  types.String                     `tfsdk:"statistic"`
+}
+
+type entityDetectorConfigurationModel struct {
+	AllowedStatistics fwtypes.ListNestedObjectValueOf[allowedStatisticsModel] `tfsdk:"allowed_statistics"`
+	EntityTypes       fwtypes.ListValueOf[types.String]                       `tfsdk:"entity_types"`
+}
+
+type allowedStatisticsModel struct {
+	Statistics fwtypes.ListValueOf[types.String] `tfsdk:"statistics"`
+}
+
+type jobSampleModel struct {
+	Mode fwtypes.StringEnum[awstypes.SampleMode] `tfsdk:"mode"`
+	Size types.Int64                             `tfsdk:"size"`
+}
+
+type validationConfigurationModel struct {
+	RulesetARN     fwtypes.ARN                                 `tfsdk:"ruleset_arn"`
+	ValidationMode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"validation_mode"`
+}
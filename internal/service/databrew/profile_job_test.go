@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewProfileJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_profile_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeProfile),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("job/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output_location.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "output_location.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_profile_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeProfile),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceProfileJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProfileJob_validationConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_profile_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_profile_job", awstypes.JobTypeProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileJobConfig_validationConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeProfile),
+					resource.TestCheckResourceAttr(resourceName, "validation_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "validation_configuration.0.ruleset_arn", "aws_databrew_ruleset.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "validation_configuration.0.validation_mode", "CHECK_ALL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProfileJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccProfileJobConfig_validationConfiguration(rName string) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), testAccRulesetConfig_rule(rName), fmt.Sprintf(`
+resource "aws_databrew_profile_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  output_location {
+    bucket = aws_s3_bucket.test.bucket
+    key    = "profile/"
+  }
+
+  validation_configuration {
+    ruleset_arn = aws_databrew_ruleset.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewProject_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_project.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_basic(rName, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("project/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe_name", "aws_databrew_recipe.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sample.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sample.0.size", "500"),
+					resource.TestCheckResourceAttr(resourceName, "sample.0.type", "FIRST_N"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfig_basic(rName, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sample.0.size", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewProject_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_project.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_basic(rName, 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceProject, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_project" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindProjectByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Project %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		_, err := tfdatabrew.FindProjectByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccProjectConfig_basic(rName string, sampleSize int) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), testAccRecipeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_project" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  recipe_name  = aws_databrew_recipe.test.name
+  role_arn     = aws_iam_role.test.arn
+
+  sample {
+    size = %[2]d
+    type = "FIRST_N"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, sampleSize))
+}
@@ -0,0 +1,500 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recipeVersionLatestPublished = "LATEST_PUBLISHED"
+	recipeVersionLatestWorking   = "LATEST_WORKING"
+)
+
+// @FrameworkResource(name="Recipe")
+// @Tags(identifierAttribute="arn")
+func newRecipeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &recipeResource{}, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*recipeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_databrew_recipe"
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"published_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"recipe_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1024),
+										},
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	input := &databrew.CreateRecipeInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	if data.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, data.ID.ValueString(), data.Description.ValueStringPointer()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.readVersions(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, data.ID.ValueString(), recipeVersionLatestWorking)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.readPublishedVersion(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	changed := !new.Description.Equal(old.Description) || !new.Steps.Equal(old.Steps)
+
+	if changed {
+		input := &databrew.UpdateRecipeInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipe(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Publish a new version whenever the working version changes, or when publishing is first enabled.
+	if new.Publish.ValueBool() && (changed || !old.Publish.ValueBool()) {
+		if err := publishRecipe(ctx, conn, new.ID.ValueString(), new.Description.ValueStringPointer()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(new.readVersions(ctx, conn)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.ID.ValueString()
+
+	// The working version can only be deleted once all published versions are gone.
+	versions, err := findPublishedRecipeVersionsByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing DataBrew Recipe (%s) versions", name), err.Error())
+
+		return
+	}
+
+	const (
+		batchSize = 50
+	)
+	for _, chunk := range tfslices.Chunks(versions, batchSize) {
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		})
+
+		if err == nil && output != nil {
+			err = recipeVersionErrors(output.Errors)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s) published versions", name), err.Error())
+
+			return
+		}
+	}
+
+	_, err = conn.DeleteRecipeVersion(ctx, &databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *recipeResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name string, description *string) error {
+	input := &databrew.PublishRecipeInput{
+		Description: description,
+		Name:        aws.String(name),
+	}
+
+	_, err := conn.PublishRecipe(ctx, input)
+
+	return err
+}
+
+func recipeVersionErrors(apiObjects []awstypes.RecipeVersionErrorDetail) error {
+	var result []error
+
+	for _, apiObject := range apiObjects {
+		result = append(result, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.RecipeVersion), aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(result...)
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := &databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+
+	output, err := conn.DescribeRecipe(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findPublishedRecipeVersionsByName(ctx context.Context, conn *databrew.Client, name string) ([]string, error) {
+	input := &databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []string
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return output, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Recipes {
+			output = append(output, aws.ToString(v.RecipeVersion))
+		}
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	Description      types.String                                     `tfsdk:"description"`
+	ID               types.String                                     `tfsdk:"id"`
+	Name             types.String                                     `tfsdk:"name"`
+	Publish          types.Bool                                       `tfsdk:"publish"`
+	PublishedVersion types.String                                     `tfsdk:"published_version"`
+	RecipeVersion    types.String                                     `tfsdk:"recipe_version"`
+	ResourceARN      types.String                                     `tfsdk:"arn"`
+	Steps            fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags             types.Map                                        `tfsdk:"tags"`
+	TagsAll          types.Map                                        `tfsdk:"tags_all"`
+}
+
+func (model *recipeResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *recipeResourceModel) setID() {
+	model.ID = model.Name
+}
+
+// readVersions sets the computed ARN and version attributes from the recipe's working and published versions.
+func (model *recipeResourceModel) readVersions(ctx context.Context, conn *databrew.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, model.ID.ValueString(), recipeVersionLatestWorking)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", model.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	model.RecipeVersion = fwflex.StringToFramework(ctx, output.RecipeVersion)
+	model.ResourceARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+
+	diags.Append(model.readPublishedVersion(ctx, conn)...)
+
+	return diags
+}
+
+func (model *recipeResourceModel) readPublishedVersion(ctx context.Context, conn *databrew.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, model.ID.ValueString(), recipeVersionLatestPublished)
+
+	switch {
+	case tfresource.NotFound(err):
+		model.PublishedVersion = types.StringNull()
+	case err != nil:
+		diags.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published version", model.ID.ValueString()), err.Error())
+	default:
+		model.PublishedVersion = fwflex.StringToFramework(ctx, output.RecipeVersion)
+	}
+
+	return diags
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String                     `tfsdk:"operation"`
+	Parameters fwtypes.MapValueOf[types.String] `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
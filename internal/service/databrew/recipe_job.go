@@ -0,0 +1,600 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Recipe Job")
+// @Tags(identifierAttribute="arn")
+func newRecipeJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &recipeJobResource{}, nil
+}
+
+type recipeJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*recipeJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_databrew_recipe_job"
+}
+
+func (r *recipeJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.ConflictsWith(path.MatchRoot("project_name")),
+				},
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"encryption_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"log_subscription": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_capacity": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 240),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_catalog_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataCatalogOutputModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrCatalogID: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+						},
+						"overwrite": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						names.AttrTableName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock(ctx),
+						"s3_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TableOutputOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrLocation: s3LocationBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"database_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[databaseOutputModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(2),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"database_output_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DatabaseOutputMode](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"glue_connection_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock(ctx),
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compression_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CompressionFormat](),
+							Optional:   true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputFormat](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_output_files": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 999),
+							},
+						},
+						"overwrite": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"partition_columns": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"format_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[outputFormatOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"csv": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[csvOutputOptionsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"delimiter": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 1),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrLocation: s3LocationBlock(ctx),
+					},
+				},
+			},
+			"recipe_reference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeReferenceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"recipe_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	input := &databrew.CreateRecipeJobInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipeJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe Job (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	output, err := findJobByTwoPartKey(ctx, conn, data.ID.ValueString(), awstypes.JobTypeRecipe)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	output, err := findJobByTwoPartKey(ctx, conn, data.ID.ValueString(), awstypes.JobTypeRecipe)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recipeJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	if !new.DataCatalogOutputs.Equal(old.DataCatalogOutputs) ||
+		!new.DatabaseOutputs.Equal(old.DatabaseOutputs) ||
+		!new.EncryptionKeyARN.Equal(old.EncryptionKeyARN) ||
+		!new.EncryptionMode.Equal(old.EncryptionMode) ||
+		!new.LogSubscription.Equal(old.LogSubscription) ||
+		!new.MaxCapacity.Equal(old.MaxCapacity) ||
+		!new.MaxRetries.Equal(old.MaxRetries) ||
+		!new.Outputs.Equal(old.Outputs) ||
+		!new.RoleARN.Equal(old.RoleARN) ||
+		!new.Timeout.Equal(old.Timeout) {
+		input := &databrew.UpdateRecipeJobInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipeJob(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe Job (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, new.ID.ValueString(), awstypes.JobTypeRecipe)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe Job (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	_, err := conn.DeleteJob(ctx, &databrew.DeleteJobInput{
+		Name: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *recipeJobResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findJobByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeJobOutput, error) {
+	input := &databrew.DescribeJobInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *databrew.Client, name string, jobType awstypes.JobType) (*databrew.DescribeJobOutput, error) {
+	output, err := findJobByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Type != jobType {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("DataBrew Job (%s) is of type %s", name, output.Type),
+		}
+	}
+
+	return output, nil
+}
+
+func databaseTableOutputOptionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[databaseTableOutputOptionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrTableName: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 255),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"temp_directory": s3LocationBlock(ctx),
+			},
+		},
+	}
+}
+
+type recipeJobResourceModel struct {
+	DataCatalogOutputs fwtypes.ListNestedObjectValueOf[dataCatalogOutputModel] `tfsdk:"data_catalog_output"`
+	DatabaseOutputs    fwtypes.ListNestedObjectValueOf[databaseOutputModel]    `tfsdk:"database_output"`
+	DatasetName        types.String                                            `tfsdk:"dataset_name"`
+	EncryptionKeyARN   fwtypes.ARN                                             `tfsdk:"encryption_key_arn"`
+	EncryptionMode     fwtypes.StringEnum[awstypes.EncryptionMode]             `tfsdk:"encryption_mode"`
+	ID                 types.String                                            `tfsdk:"id"`
+	LogSubscription    fwtypes.StringEnum[awstypes.LogSubscription]            `tfsdk:"log_subscription"`
+	MaxCapacity        types.Int64                                             `tfsdk:"max_capacity"`
+	MaxRetries         types.Int64                                             `tfsdk:"max_retries"`
+	Name               types.String                                            `tfsdk:"name"`
+	Outputs            fwtypes.ListNestedObjectValueOf[outputModel]            `tfsdk:"output"`
+	ProjectName        types.String                                            `tfsdk:"project_name"`
+	RecipeReference    fwtypes.ListNestedObjectValueOf[recipeReferenceModel]   `tfsdk:"recipe_reference"`
+	ResourceARN        types.String                                            `tfsdk:"arn"`
+	RoleARN            fwtypes.ARN                                             `tfsdk:"role_arn"`
+	Tags               types.Map                                               `tfsdk:"tags"`
+	TagsAll            types.Map                                               `tfsdk:"tags_all"`
+	Timeout            types.Int64                                             `tfsdk:"timeout"`
+}
+
+func (model *recipeJobResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *recipeJobResourceModel) setID() {
+	model.ID = model.Name
+}
+
+type dataCatalogOutputModel struct {
+	CatalogID       types.String                                                     `tfsdk:"catalog_id"`
+	DatabaseName    types.String                                                     `tfsdk:"database_name"`
+	DatabaseOptions fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	Overwrite       types.Bool                                                       `tfsdk:"overwrite"`
+	S3Options       fwtypes.ListNestedObjectValueOf[s3TableOutputOptionsModel]       `tfsdk:"s3_options"`
+	TableName       types.String                                                     `tfsdk:"table_name"`
+}
+
+type databaseTableOutputOptionsModel struct {
+	TableName     types.String                                     `tfsdk:"table_name"`
+	TempDirectory fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type s3TableOutputOptionsModel struct {
+	Location fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"location"`
+}
+
+type databaseOutputModel struct {
+	DatabaseOptions    fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	DatabaseOutputMode fwtypes.StringEnum[awstypes.DatabaseOutputMode]                  `tfsdk:"database_output_mode"`
+	GlueConnectionName types.String                                                     `tfsdk:"glue_connection_name"`
+}
+
+type outputModel struct {
+	CompressionFormat fwtypes.StringEnum[awstypes.CompressionFormat]            `tfsdk:"compression_format"`
+	Format            fwtypes.StringEnum[awstypes.OutputFormat]                 `tfsdk:"format"`
+	FormatOptions     fwtypes.ListNestedObjectValueOf[outputFormatOptionsModel] `tfsdk:"format_options"`
+	Location          fwtypes.ListNestedObjectValueOf[s3LocationModel]          `tfsdk:"location"`
+	MaxOutputFiles    types.Int64                                               `tfsdk:"max_output_files"`
+	Overwrite         types.Bool                                                `tfsdk:"overwrite"`
+	PartitionColumns  fwtypes.ListValueOf[types.String]                         `tfsdk:"partition_columns"`
+}
+
+type outputFormatOptionsModel struct {
+	Csv fwtypes.ListNestedObjectValueOf[csvOutputOptionsModel] `tfsdk:"csv"`
+}
+
+type csvOutputOptionsModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+}
+
+type recipeReferenceModel struct {
+	Name          types.String `tfsdk:"name"`
+	RecipeVersion types.String `tfsdk:"recipe_version"`
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipeJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_recipe_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_recipe_job", awstypes.JobTypeRecipe),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeRecipe),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("job/%s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_name", "aws_databrew_dataset.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "5"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "recipe_reference.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe_reference.0.name", "aws_databrew_recipe.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "recipe_reference.0.recipe_version", "aws_databrew_recipe.test", "published_version"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecipeJobConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeRecipe),
+					resource.TestCheckResourceAttr(resourceName, "max_capacity", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipeJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_recipe_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckJobDestroy(ctx, "aws_databrew_recipe_job", awstypes.JobTypeRecipe),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeJobConfig_basic(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, awstypes.JobTypeRecipe),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipeJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(ctx context.Context, resourceType string, jobType awstypes.JobType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			_, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.ID, jobType)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, jobType awstypes.JobType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		_, err := tfdatabrew.FindJobByTwoPartKey(ctx, conn, rs.Primary.ID, jobType)
+
+		return err
+	}
+}
+
+func testAccJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "databrew.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AwsGlueDataBrewDataAccessPolicy"
+}
+`, rName))
+}
+
+func testAccRecipeJobConfig_basic(rName string, maxCapacity int) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), testAccRecipeConfig_publish(rName, "test", "UPPER_CASE"), fmt.Sprintf(`
+resource "aws_databrew_recipe_job" "test" {
+  name         = %[1]q
+  dataset_name = aws_databrew_dataset.test.name
+  max_capacity = %[2]d
+  role_arn     = aws_iam_role.test.arn
+
+  output {
+    format = "CSV"
+
+    location {
+      bucket = aws_s3_bucket.test.bucket
+      key    = "output/"
+    }
+  }
+
+  recipe_reference {
+    name           = aws_databrew_recipe.test.name
+    recipe_version = aws_databrew_recipe.test.published_version
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, maxCapacity))
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipe_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_recipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("recipe/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "publish", acctest.CtFalse),
+					resource.TestCheckNoResourceAttr(resourceName, "published_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "recipe_version"),
+					resource.TestCheckResourceAttr(resourceName, "step.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.operation", "UPPER_CASE"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.sourceColumn", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_recipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipe, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_publish(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_recipe.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_publish(rName, "first", "UPPER_CASE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, "publish", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "published_version", "1.0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish"},
+			},
+			{
+				Config: testAccRecipeConfig_publish(rName, "second", "LOWER_CASE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+					resource.TestCheckResourceAttr(resourceName, "published_version", "2.0"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.operation", "LOWER_CASE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecipeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_recipe" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.ID, "LATEST_WORKING")
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Recipe %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecipeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		_, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.ID, "LATEST_WORKING")
+
+		return err
+	}
+}
+
+func testAccRecipeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name = %[1]q
+
+  step {
+    action {
+      operation = "UPPER_CASE"
+
+      parameters = {
+        sourceColumn = "name"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccRecipeConfig_publish(rName, description, operation string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name        = %[1]q
+  description = %[2]q
+  publish     = true
+
+  step {
+    action {
+      operation = %[3]q
+
+      parameters = {
+        sourceColumn = "name"
+      }
+    }
+  }
+}
+`, rName, description, operation)
+}
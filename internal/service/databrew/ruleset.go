@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Ruleset")
+// @Tags(identifierAttribute="arn")
+func newRulesetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &rulesetResource{}, nil
+}
+
+type rulesetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*rulesetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_databrew_ruleset"
+}
+
+func (r *rulesetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTargetARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ruleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"check_expression": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(4, 1024),
+							},
+						},
+						"disabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"substitution_map": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"column_selector": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+									"regex": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"threshold": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[thresholdModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ThresholdType](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrUnit: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ThresholdUnit](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrValue: schema.Float64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *rulesetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rulesetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	input := &databrew.CreateRulesetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRuleset(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Ruleset (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	output, err := findRulesetByName(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Ruleset (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rulesetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rulesetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	output, err := findRulesetByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Ruleset (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rulesetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new rulesetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	if !new.Description.Equal(old.Description) || !new.Rules.Equal(old.Rules) {
+		input := &databrew.UpdateRulesetInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRuleset(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Ruleset (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findRulesetByName(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Ruleset (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *rulesetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data rulesetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	_, err := conn.DeleteRuleset(ctx, &databrew.DeleteRulesetInput{
+		Name: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Ruleset (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *rulesetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findRulesetByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeRulesetOutput, error) {
+	input := &databrew.DescribeRulesetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeRuleset(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type rulesetResourceModel struct {
+	Description types.String                               `tfsdk:"description"`
+	ID          types.String                               `tfsdk:"id"`
+	Name        types.String                               `tfsdk:"name"`
+	ResourceARN types.String                               `tfsdk:"arn"`
+	Rules       fwtypes.ListNestedObjectValueOf[ruleModel] `tfsdk:"rule"`
+	Tags        types.Map                                  `tfsdk:"tags"`
+	TagsAll     types.Map                                  `tfsdk:"tags_all"`
+	TargetARN   fwtypes.ARN                                `tfsdk:"target_arn"`
+}
+
+func (model *rulesetResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *rulesetResourceModel) setID() {
+	model.ID = model.Name
+}
+
+type ruleModel struct {
+	CheckExpression types.String                                         `tfsdk:"check_expression"`
+	ColumnSelectors fwtypes.ListNestedObjectValueOf[columnSelectorModel] `tfsdk:"column_selector"`
+	Disabled        types.Bool                                           `tfsdk:"disabled"`
+	Name            types.String                                         `tfsdk:"name"`
+	SubstitutionMap fwtypes.MapValueOf[types.String]                     `tfsdk:"substitution_map"`
+	Threshold       fwtypes.ListNestedObjectValueOf[thresholdModel]      `tfsdk:"threshold"`
+}
+
+type thresholdModel struct {
+	Type  fwtypes.StringEnum[awstypes.ThresholdType] `tfsdk:"type"`
+	Unit  fwtypes.StringEnum[awstypes.ThresholdUnit] `tfsdk:"unit"`
+	Value types.Float64                              `tfsdk:"value"`
+}
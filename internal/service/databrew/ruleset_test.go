@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRuleset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_ruleset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(testAccDatasetConfig_basic(rName), testAccRulesetConfig_rule(rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("ruleset/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.check_expression", ":col1 > :val1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.disabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "id-positive"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.substitution_map.%", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_databrew_dataset.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRuleset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_ruleset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(testAccDatasetConfig_basic(rName), testAccRulesetConfig_rule(rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRuleset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRulesetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_ruleset" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Ruleset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRulesetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		_, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// testAccRulesetConfig_rule must be composed with a configuration declaring aws_databrew_dataset.test.
+func testAccRulesetConfig_rule(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name       = %[1]q
+  target_arn = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "id-positive"
+    check_expression = ":col1 > :val1"
+
+    substitution_map = {
+      ":col1" = "`+"`id`"+`"
+      ":val1" = "0"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewSchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_basic(rName, "cron(0 12 * * ? *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduleExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "databrew", fmt.Sprintf("schedule/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "cron(0 12 * * ? *)"),
+					resource.TestCheckResourceAttr(resourceName, "job_names.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "job_names.*", "aws_databrew_profile_job.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScheduleConfig_basic(rName, "cron(30 6 * * ? *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "cron(30 6 * * ? *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewSchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_databrew_schedule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduleConfig_basic(rName, "cron(0 12 * * ? *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduleExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceSchedule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_schedule" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindScheduleByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Schedule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		_, err := tfdatabrew.FindScheduleByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccScheduleConfig_basic(rName, cronExpression string) string {
+	return acctest.ConfigCompose(testAccProfileJobConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_schedule" "test" {
+  name            = %[1]q
+  cron_expression = %[2]q
+  job_names       = [aws_databrew_profile_job.test.name]
+}
+`, rName, cronExpression))
+}
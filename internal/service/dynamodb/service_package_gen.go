@@ -37,6 +37,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_dynamodb_table_item",
 			Name:     "Table Item",
 		},
+		{
+			Factory:  dataSourceTables,
+			TypeName: "aws_dynamodb_tables",
+			Name:     "Tables",
+		},
 	}
 }
 
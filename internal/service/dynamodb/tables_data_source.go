@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dynamodb_tables", name="Tables")
+func dataSourceTables() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTablesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"billing_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replica_regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrStreamARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	filter := tfslices.PredicateTrue[string]()
+	if v, ok := d.GetOk(names.AttrNamePrefix); ok {
+		prefix := v.(string)
+		filter = func(name string) bool {
+			return strings.HasPrefix(name, prefix)
+		}
+	}
+
+	tableNames, err := findTableNames(ctx, conn, &dynamodb.ListTablesInput{}, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing DynamoDB Tables: %s", err)
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS()
+
+	var arns, tableNamesOut []string
+	var tfList []interface{}
+
+	for _, name := range tableNames {
+		table, err := findTableByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s): %s", name, err)
+		}
+
+		arn := aws.ToString(table.TableArn)
+
+		if len(tagsToMatch) > 0 {
+			tags, err := listTags(ctx, conn, arn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for DynamoDB Table (%s): %s", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+		tableNamesOut = append(tableNamesOut, name)
+		tfList = append(tfList, flattenTableSummary(table))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, tableNamesOut)
+	if err := d.Set("table", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting table: %s", err)
+	}
+
+	return diags
+}
+
+func findTableNames(ctx context.Context, conn *dynamodb.Client, input *dynamodb.ListTablesInput, filter tfslices.Predicate[string]) ([]string, error) {
+	var output []string
+
+	pages := dynamodb.NewListTablesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TableNames {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func flattenTableSummary(apiObject *awstypes.TableDescription) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrARN:       aws.ToString(apiObject.TableArn),
+		"billing_mode":      string(awstypes.BillingModeProvisioned),
+		names.AttrName:      aws.ToString(apiObject.TableName),
+		names.AttrStreamARN: aws.ToString(apiObject.LatestStreamArn),
+	}
+
+	if apiObject.BillingModeSummary != nil {
+		tfMap["billing_mode"] = string(apiObject.BillingModeSummary.BillingMode)
+	}
+
+	var replicaRegions []string
+	for _, v := range apiObject.Replicas {
+		replicaRegions = append(replicaRegions, aws.ToString(v.RegionName))
+	}
+	tfMap["replica_regions"] = replicaRegions
+
+	return tfMap
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTablesDataSource_namePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_dynamodb_tables.test"
+	resource1Name := "aws_dynamodb_table.test1"
+	resource2Name := "aws_dynamodb_table.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTablesDataSourceConfig_namePrefix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", resource1Name, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.1", resource2Name, names.AttrARN),
+					resource.TestCheckResourceAttr(datasourceName, "names.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.0", resource1Name, names.AttrName),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.1", resource2Name, names.AttrName),
+					resource.TestCheckResourceAttr(datasourceName, "table.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(datasourceName, "table.0.billing_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttr(datasourceName, "table.0.replica_regions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(datasourceName, "table.0.stream_arn", ""),
+					resource.TestCheckResourceAttr(datasourceName, "table.1.billing_mode", "PROVISIONED"),
+					resource.TestCheckResourceAttrPair(datasourceName, "table.1.stream_arn", resource2Name, names.AttrStreamARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTablesDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_dynamodb_tables.test"
+	resourceName := "aws_dynamodb_table.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTablesDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(datasourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(datasourceName, "names.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(datasourceName, "names.0", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTablesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test1" {
+  name         = "%[1]s-1"
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_dynamodb_table" "test2" {
+  name             = "%[1]s-2"
+  read_capacity    = 1
+  write_capacity   = 1
+  hash_key         = "TestTableHashKey"
+  stream_enabled   = true
+  stream_view_type = "KEYS_ONLY"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  tags = {
+    Name = %[1]q
+    Team = "monitoring"
+  }
+}
+`, rName)
+}
+
+func testAccTablesDataSourceConfig_namePrefix(rName string) string {
+	return acctest.ConfigCompose(testAccTablesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_dynamodb_tables" "test" {
+  name_prefix = %[1]q
+
+  depends_on = [aws_dynamodb_table.test1, aws_dynamodb_table.test2]
+}
+`, rName))
+}
+
+func testAccTablesDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccTablesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_dynamodb_tables" "test" {
+  name_prefix = %[1]q
+
+  tags = {
+    Name = %[1]q
+    Team = "monitoring"
+  }
+
+  depends_on = [aws_dynamodb_table.test1, aws_dynamodb_table.test2]
+}
+`, rName))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECRRepositoryCreationTemplateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	repositoryPrefix := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_ecr_repository_creation_template.test"
+	dataSourceName := "data.aws_ecr_repository_creation_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryCreationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryCreationTemplateDataSourceConfig_basic(repositoryPrefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "applied_for.#", resourceName, "applied_for.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "encryption_configuration.#", resourceName, "encryption_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "encryption_configuration.0.encryption_type", resourceName, "encryption_configuration.0.encryption_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_tag_mutability", resourceName, "image_tag_mutability"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrPrefix, resourceName, names.AttrPrefix),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "registry_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tags.Foo", "Bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryCreationTemplateDataSourceConfig_basic(repositoryPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository_creation_template" "test" {
+  prefix      = %[1]q
+  description = "Test description"
+
+  applied_for = [
+    "PULL_THROUGH_CACHE",
+  ]
+
+  resource_tags = {
+    Foo = "Bar"
+  }
+}
+
+data "aws_ecr_repository_creation_template" "test" {
+  prefix = aws_ecr_repository_creation_template.test.prefix
+}
+`, repositoryPrefix)
+}
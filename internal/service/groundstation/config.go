@@ -0,0 +1,728 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_config", name="Config")
+// @Tags(identifierAttribute="arn")
+func newConfigResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configResource{}, nil
+}
+
+type configResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_config"
+}
+
+func (r *configResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"config_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigCapabilityType](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z _:-]+$`), "must contain only letters, numbers, spaces, underscores (_), colons (:) and hyphens (-)"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"antenna_downlink_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[antennaDownlinkConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"spectrum_config": spectrumConfigBlock(ctx),
+					},
+				},
+			},
+			"antenna_downlink_demod_decode_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[antennaDownlinkDemodDecodeConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"decode_config":       unvalidatedJSONBlock(ctx),
+						"demodulation_config": unvalidatedJSONBlock(ctx),
+						"spectrum_config":     spectrumConfigBlock(ctx),
+					},
+				},
+			},
+			"antenna_uplink_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[antennaUplinkConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"transmit_disabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"spectrum_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[uplinkSpectrumConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"polarization": polarizationAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"center_frequency": unitValueBlock[frequencyModel, awstypes.FrequencyUnits](ctx),
+								},
+							},
+						},
+						"target_eirp": unitValueBlock[eirpModel, awstypes.EirpUnits](ctx),
+					},
+				},
+			},
+			"dataflow_endpoint_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEndpointConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"dataflow_endpoint_name": schema.StringAttribute{
+							Required: true,
+						},
+						"dataflow_endpoint_region": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"s3_recording_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[s3RecordingConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"bucket_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrPrefix: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 900),
+							},
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"tracking_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[trackingConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"autotrack": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Criticality](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"uplink_echo_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[uplinkEchoConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"antenna_uplink_config_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrEnabled: schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func spectrumConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[spectrumConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"polarization": polarizationAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"bandwidth":        unitValueBlock[frequencyBandwidthModel, awstypes.BandwidthUnits](ctx),
+				"center_frequency": unitValueBlock[frequencyModel, awstypes.FrequencyUnits](ctx),
+			},
+		},
+	}
+}
+
+func polarizationAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func unitValueBlock[T any, U enum.Valueser[U]](ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrUnit: schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[U](),
+					Required:   true,
+				},
+				names.AttrValue: schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func unvalidatedJSONBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[unvalidatedJSONModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"unvalidated_json": schema.StringAttribute{
+					CustomType: jsontypes.NormalizedType{},
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func (r *configResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("antenna_downlink_config"),
+			path.MatchRoot("antenna_downlink_demod_decode_config"),
+			path.MatchRoot("antenna_uplink_config"),
+			path.MatchRoot("dataflow_endpoint_config"),
+			path.MatchRoot("s3_recording_config"),
+			path.MatchRoot("tracking_config"),
+			path.MatchRoot("uplink_echo_config"),
+		),
+	}
+}
+
+func (r *configResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	configData, diags := data.expandConfigData(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &groundstation.CreateConfigInput{
+		ConfigData: configData,
+		Name:       aws.String(data.Name.ValueString()),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Config (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ConfigARN = fwflex.StringToFramework(ctx, output.ConfigArn)
+	data.ConfigID = fwflex.StringToFramework(ctx, output.ConfigId)
+	data.ConfigType = fwtypes.StringEnumValue(output.ConfigType)
+	data.setID()
+
+	config, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenConfigData(ctx, config.ConfigData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ConfigARN = fwflex.StringToFramework(ctx, output.ConfigArn)
+	data.Name = fwflex.StringToFramework(ctx, output.Name)
+	response.Diagnostics.Append(data.flattenConfigData(ctx, output.ConfigData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.Name.Equal(old.Name) ||
+		!new.AntennaDownlinkConfig.Equal(old.AntennaDownlinkConfig) ||
+		!new.AntennaDownlinkDemodDecodeConfig.Equal(old.AntennaDownlinkDemodDecodeConfig) ||
+		!new.AntennaUplinkConfig.Equal(old.AntennaUplinkConfig) ||
+		!new.DataflowEndpointConfig.Equal(old.DataflowEndpointConfig) ||
+		!new.S3RecordingConfig.Equal(old.S3RecordingConfig) ||
+		!new.TrackingConfig.Equal(old.TrackingConfig) ||
+		!new.UplinkEchoConfig.Equal(old.UplinkEchoConfig) {
+		configData, diags := new.expandConfigData(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: configData,
+			ConfigId:   aws.String(new.ConfigID.ValueString()),
+			ConfigType: new.ConfigType.ValueEnum(),
+			Name:       aws.String(new.Name.ValueString()),
+		}
+
+		_, err := conn.UpdateConfig(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Config (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		config, err := findConfigByTwoPartKey(ctx, conn, new.ConfigID.ValueString(), new.ConfigType.ValueEnum())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flattenConfigData(ctx, config.ConfigData)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteConfig(ctx, &groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(data.ConfigID.ValueString()),
+		ConfigType: data.ConfigType.ValueEnum(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.Plan.Raw.IsNull() {
+		var new configResourceModel
+		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newConfigType := new.configType()
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("config_type"), fwtypes.StringEnumValue(newConfigType))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// A config's type cannot be changed.
+		if !request.State.Raw.IsNull() {
+			var old configResourceModel
+			response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if oldConfigType := old.ConfigType.ValueEnum(); newConfigType != oldConfigType {
+				response.RequiresReplace = []path.Path{path.Root("config_type")}
+			}
+		}
+	}
+
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findConfigByTwoPartKey(ctx context.Context, conn *groundstation.Client, configID string, configType awstypes.ConfigCapabilityType) (*groundstation.GetConfigOutput, error) {
+	input := &groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: configType,
+	}
+
+	output, err := conn.GetConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigData == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type configResourceModel struct {
+	AntennaDownlinkConfig            fwtypes.ListNestedObjectValueOf[antennaDownlinkConfigModel]            `tfsdk:"antenna_downlink_config"`
+	AntennaDownlinkDemodDecodeConfig fwtypes.ListNestedObjectValueOf[antennaDownlinkDemodDecodeConfigModel] `tfsdk:"antenna_downlink_demod_decode_config"`
+	AntennaUplinkConfig              fwtypes.ListNestedObjectValueOf[antennaUplinkConfigModel]              `tfsdk:"antenna_uplink_config"`
+	ConfigARN                        types.String                                                           `tfsdk:"arn"`
+	ConfigID                         types.String                                                           `tfsdk:"config_id"`
+	ConfigType                       fwtypes.StringEnum[awstypes.ConfigCapabilityType]                      `tfsdk:"config_type"`
+	DataflowEndpointConfig           fwtypes.ListNestedObjectValueOf[dataflowEndpointConfigModel]           `tfsdk:"dataflow_endpoint_config"`
+	ID                               types.String                                                           `tfsdk:"id"`
+	Name                             types.String                                                           `tfsdk:"name"`
+	S3RecordingConfig                fwtypes.ListNestedObjectValueOf[s3RecordingConfigModel]                `tfsdk:"s3_recording_config"`
+	Tags                             types.Map                                                              `tfsdk:"tags"`
+	TagsAll                          types.Map                                                              `tfsdk:"tags_all"`
+	TrackingConfig                   fwtypes.ListNestedObjectValueOf[trackingConfigModel]                   `tfsdk:"tracking_config"`
+	UplinkEchoConfig                 fwtypes.ListNestedObjectValueOf[uplinkEchoConfigModel]                 `tfsdk:"uplink_echo_config"`
+}
+
+const (
+	configResourceIDPartCount = 2
+)
+
+func (data *configResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ConfigID = types.StringValue(parts[0])
+	data.ConfigType = fwtypes.StringEnumValue(awstypes.ConfigCapabilityType(parts[1]))
+
+	return nil
+}
+
+func (data *configResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ConfigID.ValueString(), string(data.ConfigType.ValueEnum())}, configResourceIDPartCount, false)))
+}
+
+// configType returns the capability type of the configured config data.
+func (data *configResourceModel) configType() awstypes.ConfigCapabilityType {
+	switch {
+	case !data.AntennaDownlinkConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeAntennaDownlink
+	case !data.AntennaDownlinkDemodDecodeConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeAntennaDownlinkDemodDecode
+	case !data.AntennaUplinkConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeAntennaUplink
+	case !data.DataflowEndpointConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeDataflowEndpoint
+	case !data.S3RecordingConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeS3Recording
+	case !data.TrackingConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeTracking
+	case !data.UplinkEchoConfig.IsNull():
+		return awstypes.ConfigCapabilityTypeUplinkEcho
+	}
+
+	return ""
+}
+
+func (data *configResourceModel) expandConfigData(ctx context.Context) (awstypes.ConfigTypeData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch data.configType() {
+	case awstypes.ConfigCapabilityTypeAntennaDownlink:
+		var apiObject awstypes.ConfigTypeDataMemberAntennaDownlinkConfig
+		diags.Append(fwflex.Expand(ctx, data.AntennaDownlinkConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	case awstypes.ConfigCapabilityTypeAntennaDownlinkDemodDecode:
+		var apiObject awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig
+		diags.Append(fwflex.Expand(ctx, data.AntennaDownlinkDemodDecodeConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	case awstypes.ConfigCapabilityTypeAntennaUplink:
+		var apiObject awstypes.ConfigTypeDataMemberAntennaUplinkConfig
+		diags.Append(fwflex.Expand(ctx, data.AntennaUplinkConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	case awstypes.ConfigCapabilityTypeDataflowEndpoint:
+		var apiObject awstypes.ConfigTypeDataMemberDataflowEndpointConfig
+		diags.Append(fwflex.Expand(ctx, data.DataflowEndpointConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	case awstypes.ConfigCapabilityTypeS3Recording:
+		var apiObject awstypes.ConfigTypeDataMemberS3RecordingConfig
+		diags.Append(fwflex.Expand(ctx, data.S3RecordingConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	case awstypes.ConfigCapabilityTypeTracking:
+		var apiObject awstypes.ConfigTypeDataMemberTrackingConfig
+		diags.Append(fwflex.Expand(ctx, data.TrackingConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	case awstypes.ConfigCapabilityTypeUplinkEcho:
+		var apiObject awstypes.ConfigTypeDataMemberUplinkEchoConfig
+		diags.Append(fwflex.Expand(ctx, data.UplinkEchoConfig, &apiObject.Value)...)
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func (data *configResourceModel) flattenConfigData(ctx context.Context, apiObject awstypes.ConfigTypeData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.AntennaDownlinkConfig = fwtypes.NewListNestedObjectValueOfNull[antennaDownlinkConfigModel](ctx)
+	data.AntennaDownlinkDemodDecodeConfig = fwtypes.NewListNestedObjectValueOfNull[antennaDownlinkDemodDecodeConfigModel](ctx)
+	data.AntennaUplinkConfig = fwtypes.NewListNestedObjectValueOfNull[antennaUplinkConfigModel](ctx)
+	data.DataflowEndpointConfig = fwtypes.NewListNestedObjectValueOfNull[dataflowEndpointConfigModel](ctx)
+	data.S3RecordingConfig = fwtypes.NewListNestedObjectValueOfNull[s3RecordingConfigModel](ctx)
+	data.TrackingConfig = fwtypes.NewListNestedObjectValueOfNull[trackingConfigModel](ctx)
+	data.UplinkEchoConfig = fwtypes.NewListNestedObjectValueOfNull[uplinkEchoConfigModel](ctx)
+
+	switch v := apiObject.(type) {
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.AntennaDownlinkConfig)...)
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.AntennaDownlinkDemodDecodeConfig)...)
+	case *awstypes.ConfigTypeDataMemberAntennaUplinkConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.AntennaUplinkConfig)...)
+	case *awstypes.ConfigTypeDataMemberDataflowEndpointConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.DataflowEndpointConfig)...)
+	case *awstypes.ConfigTypeDataMemberS3RecordingConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.S3RecordingConfig)...)
+	case *awstypes.ConfigTypeDataMemberTrackingConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.TrackingConfig)...)
+	case *awstypes.ConfigTypeDataMemberUplinkEchoConfig:
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &data.UplinkEchoConfig)...)
+	}
+
+	return diags
+}
+
+type antennaDownlinkConfigModel struct {
+	SpectrumConfig fwtypes.ListNestedObjectValueOf[spectrumConfigModel] `tfsdk:"spectrum_config"`
+}
+
+type antennaDownlinkDemodDecodeConfigModel struct {
+	DecodeConfig       fwtypes.ListNestedObjectValueOf[unvalidatedJSONModel] `tfsdk:"decode_config"`
+	DemodulationConfig fwtypes.ListNestedObjectValueOf[unvalidatedJSONModel] `tfsdk:"demodulation_config"`
+	SpectrumConfig     fwtypes.ListNestedObjectValueOf[spectrumConfigModel]  `tfsdk:"spectrum_config"`
+}
+
+type antennaUplinkConfigModel struct {
+	SpectrumConfig   fwtypes.ListNestedObjectValueOf[uplinkSpectrumConfigModel] `tfsdk:"spectrum_config"`
+	TargetEirp       fwtypes.ListNestedObjectValueOf[eirpModel]                 `tfsdk:"target_eirp"`
+	TransmitDisabled types.Bool                                                 `tfsdk:"transmit_disabled"`
+}
+
+type dataflowEndpointConfigModel struct {
+	DataflowEndpointName   types.String `tfsdk:"dataflow_endpoint_name"`
+	DataflowEndpointRegion types.String `tfsdk:"dataflow_endpoint_region"`
+}
+
+type s3RecordingConfigModel struct {
+	BucketARN fwtypes.ARN  `tfsdk:"bucket_arn"`
+	Prefix    types.String `tfsdk:"prefix"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type trackingConfigModel struct {
+	Autotrack fwtypes.StringEnum[awstypes.Criticality] `tfsdk:"autotrack"`
+}
+
+type uplinkEchoConfigModel struct {
+	AntennaUplinkConfigARN fwtypes.ARN `tfsdk:"antenna_uplink_config_arn"`
+	Enabled                types.Bool  `tfsdk:"enabled"`
+}
+
+type spectrumConfigModel struct {
+	Bandwidth       fwtypes.ListNestedObjectValueOf[frequencyBandwidthModel] `tfsdk:"bandwidth"`
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel]          `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]                `tfsdk:"polarization"`
+}
+
+type uplinkSpectrumConfigModel struct {
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel] `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]       `tfsdk:"polarization"`
+}
+
+type frequencyModel struct {
+	Units fwtypes.StringEnum[awstypes.FrequencyUnits] `tfsdk:"unit"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type frequencyBandwidthModel struct {
+	Units fwtypes.StringEnum[awstypes.BandwidthUnits] `tfsdk:"unit"`
+	Value types.Float64                               `tfsdk:"value"`
+}
+
+type eirpModel struct {
+	Units fwtypes.StringEnum[awstypes.EirpUnits] `tfsdk:"unit"`
+	Value types.Float64                          `tfsdk:"value"`
+}
+
+type unvalidatedJSONModel struct {
+	UnvalidatedJSON jsontypes.Normalized `tfsdk:"unvalidated_json"`
+}
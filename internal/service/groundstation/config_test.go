@@ -0,0 +1,639 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "groundstation", regexache.MustCompile(`config/tracking/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "config_id"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeTracking)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "tracking_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tracking_config.0.autotrack", "PREFERRED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceConfig, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tracking_config.0.autotrack", "PREFERRED"),
+				),
+			},
+			{
+				Config: testAccConfigConfig_tracking(rName+"-updated", "REQUIRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_config.0.autotrack", "REQUIRED"),
+				),
+			},
+			{
+				Config: testAccConfigConfig_dataflowEndpoint(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeDataflowEndpoint)),
+					resource.TestCheckResourceAttr(resourceName, "dataflow_endpoint_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tracking_config.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlink(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaDownlink(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeAntennaDownlink)),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_config.0.spectrum_config.0.bandwidth.0.unit", "MHz"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_config.0.spectrum_config.0.bandwidth.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_config.0.spectrum_config.0.center_frequency.0.unit", "MHz"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_config.0.spectrum_config.0.center_frequency.0.value", "7812"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_config.0.spectrum_config.0.polarization", "RIGHT_HAND"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlinkDemodDecode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaDownlinkDemodDecode(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeAntennaDownlinkDemodDecode)),
+					resource.TestCheckResourceAttr(resourceName, "antenna_downlink_demod_decode_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "antenna_downlink_demod_decode_config.0.decode_config.0.unvalidated_json"),
+					resource.TestCheckResourceAttrSet(resourceName, "antenna_downlink_demod_decode_config.0.demodulation_config.0.unvalidated_json"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaUplinkAndUplinkEcho(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	echoResourceName := "aws_groundstation_config.echo"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaUplinkAndUplinkEcho(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeAntennaUplink)),
+					resource.TestCheckResourceAttr(resourceName, "antenna_uplink_config.0.spectrum_config.0.center_frequency.0.value", "2025"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_uplink_config.0.target_eirp.0.unit", "dBW"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_uplink_config.0.target_eirp.0.value", "20"),
+					resource.TestCheckResourceAttr(resourceName, "antenna_uplink_config.0.transmit_disabled", acctest.CtFalse),
+					testAccCheckConfigExists(ctx, echoResourceName, &v),
+					resource.TestCheckResourceAttr(echoResourceName, "config_type", string(awstypes.ConfigCapabilityTypeUplinkEcho)),
+					resource.TestCheckResourceAttrPair(echoResourceName, "uplink_echo_config.0.antenna_uplink_config_arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(echoResourceName, "uplink_echo_config.0.enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_s3Recording(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetConfigOutput
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_s3Recording(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "config_type", string(awstypes.ConfigCapabilityTypeS3Recording)),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_recording_config.0.bucket_arn", "aws_s3_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "s3_recording_config.0.prefix", "recordings/"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_recording_config.0.role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_config" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["config_id"], awstypes.ConfigCapabilityType(rs.Primary.Attributes["config_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfigExists(ctx context.Context, n string, v *groundstation.GetConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["config_id"], awstypes.ConfigCapabilityType(rs.Primary.Attributes["config_type"]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+	input := &groundstation.ListConfigsInput{}
+	_, err := conn.ListConfigs(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccConfigConfig_tracking(rName, autotrack string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  tracking_config {
+    autotrack = %[2]q
+  }
+}
+`, rName, autotrack)
+}
+
+func testAccConfigConfig_dataflowEndpoint(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  dataflow_endpoint_config {
+    dataflow_endpoint_name   = %[1]q
+    dataflow_endpoint_region = data.aws_region.current.name
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_antennaDownlink(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  antenna_downlink_config {
+    spectrum_config {
+      polarization = "RIGHT_HAND"
+
+      bandwidth {
+        unit  = "MHz"
+        value = 30
+      }
+
+      center_frequency {
+        unit  = "MHz"
+        value = 7812
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_antennaDownlinkDemodDecode(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  antenna_downlink_demod_decode_config {
+    spectrum_config {
+      polarization = "RIGHT_HAND"
+
+      bandwidth {
+        unit  = "MHz"
+        value = 125
+      }
+
+      center_frequency {
+        unit  = "MHz"
+        value = 8212.5
+      }
+    }
+
+    demodulation_config {
+      unvalidated_json = jsonencode({
+        type = "QPSK"
+        qpsk = {
+          carrierFrequencyRecovery = {
+            centerFrequency = {
+              value = 8212.5
+              units = "MHz"
+            }
+            range = {
+              value = 250
+              units = "kHz"
+            }
+          }
+          symbolTimingRecovery = {
+            symbolRate = {
+              value = 115
+              units = "Msps"
+            }
+            range = {
+              value = 0.75
+              units = "ksps"
+            }
+            matchedFilter = {
+              type          = "ROOT_RAISED_COSINE"
+              rolloffFactor = 0.35
+            }
+          }
+        }
+      })
+    }
+
+    decode_config {
+      unvalidated_json = jsonencode({
+        edges = [
+          {
+            from = "I-Ingress"
+            to   = "IQ-Recombiner"
+          },
+          {
+            from = "Q-Ingress"
+            to   = "IQ-Recombiner"
+          },
+          {
+            from = "IQ-Recombiner"
+            to   = "CcsdsViterbiDecoder"
+          },
+          {
+            from = "CcsdsViterbiDecoder"
+            to   = "NrzmDecoder"
+          },
+          {
+            from = "NrzmDecoder"
+            to   = "UncodedFramesEgress"
+          }
+        ]
+        nodeConfigs = {
+          I-Ingress = {
+            type = "CODED_SYMBOLS_INGRESS"
+            codedSymbolsIngress = {
+              source = "I"
+            }
+          }
+          Q-Ingress = {
+            type = "CODED_SYMBOLS_INGRESS"
+            codedSymbolsIngress = {
+              source = "Q"
+            }
+          }
+          IQ-Recombiner = {
+            type = "IQ_RECOMBINER"
+          }
+          CcsdsViterbiDecoder = {
+            type = "CCSDS_171_133_VITERBI_DECODER"
+            ccsds171133ViterbiDecoder = {
+              codeRate = "ONE_HALF"
+            }
+          }
+          NrzmDecoder = {
+            type = "NRZM_DECODER"
+          }
+          UncodedFramesEgress = {
+            type = "UNCODED_FRAMES_EGRESS"
+          }
+        }
+      })
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_antennaUplinkAndUplinkEcho(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  antenna_uplink_config {
+    transmit_disabled = false
+
+    spectrum_config {
+      polarization = "RIGHT_HAND"
+
+      center_frequency {
+        unit  = "MHz"
+        value = 2025
+      }
+    }
+
+    target_eirp {
+      unit  = "dBW"
+      value = 20
+    }
+  }
+}
+
+resource "aws_groundstation_config" "echo" {
+  name = "%[1]s-echo"
+
+  uplink_echo_config {
+    antenna_uplink_config_arn = aws_groundstation_config.test.arn
+    enabled                   = true
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_s3Recording(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-groundstation-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "groundstation.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetBucketLocation", "s3:PutObject"]
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  s3_recording_config {
+    bucket_arn = aws_s3_bucket.test.arn
+    prefix     = "recordings/"
+    role_arn   = aws_iam_role.test.arn
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+func testAccConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  tracking_config {
+    autotrack = "PREFERRED"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  tracking_config {
+    autotrack = "PREFERRED"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
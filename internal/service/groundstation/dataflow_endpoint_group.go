@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_dataflow_endpoint_group", name="Dataflow Endpoint Group")
+// @Tags(identifierAttribute="arn")
+func newDataflowEndpointGroupResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &dataflowEndpointGroupResource{}, nil
+}
+
+type dataflowEndpointGroupResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*dataflowEndpointGroupResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_dataflow_endpoint_group"
+}
+
+func (r *dataflowEndpointGroupResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	socketAddressBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[socketAddressModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: schema.StringAttribute{
+						Required: true,
+					},
+					names.AttrPort: schema.Int64Attribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:                        framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": endpointGroupContactPassDurationAttribute(),
+			"contact_pre_pass_duration_seconds":  endpointGroupContactPassDurationAttribute(),
+			names.AttrID:                         framework.IDAttribute(),
+			names.AttrTags:                       tftags.TagsAttribute(),
+			names.AttrTagsAll:                    tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"endpoint_details": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[endpointDetailsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_ground_station_agent_endpoint": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[awsGroundStationAgentEndpointModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("aws_ground_station_agent_endpoint"),
+									path.MatchRelative().AtParent().AtName("endpoint"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"egress_address": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[connectionDetailsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"mtu": schema.Int64Attribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"socket_address": socketAddressBlock(),
+											},
+										},
+									},
+									"ingress_address": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rangedConnectionDetailsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"mtu": schema.Int64Attribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"socket_address": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[rangedSocketAddressModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Required: true,
+															},
+														},
+														Blocks: map[string]schema.Block{
+															"port_range": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[integerRangeModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"maximum": schema.Int64Attribute{
+																			Required: true,
+																		},
+																		"minimum": schema.Int64Attribute{
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"endpoint": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEndpointModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"mtu": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1400, 1500),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrAddress: socketAddressBlock(),
+								},
+							},
+						},
+						"security_details": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[securityDetailsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrSecurityGroupIDs: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									names.AttrSubnetIDs: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func endpointGroupContactPassDurationAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(120, 21600),
+		},
+	}
+}
+
+func (r *dataflowEndpointGroupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataflowEndpointGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	input := &groundstation.CreateDataflowEndpointGroupInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataflowEndpointGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Ground Station Dataflow Endpoint Group", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.DataflowEndpointGroupId)
+
+	dataflowEndpointGroup, err := findDataflowEndpointGroupByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Dataflow Endpoint Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DataflowEndpointGroupARN = fwflex.StringToFramework(ctx, dataflowEndpointGroup.DataflowEndpointGroupArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataflowEndpointGroupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataflowEndpointGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findDataflowEndpointGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Dataflow Endpoint Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.EndpointsDetails, &data.EndpointDetails)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dataflowEndpointGroupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new dataflowEndpointGroupResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only tags can be updated.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dataflowEndpointGroupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dataflowEndpointGroupResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteDataflowEndpointGroup(ctx, &groundstation.DeleteDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Dataflow Endpoint Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *dataflowEndpointGroupResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDataflowEndpointGroupByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetDataflowEndpointGroupOutput, error) {
+	input := &groundstation.GetDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetDataflowEndpointGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type dataflowEndpointGroupResourceModel struct {
+	ContactPostPassDurationSeconds types.Int64                                           `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds  types.Int64                                           `tfsdk:"contact_pre_pass_duration_seconds"`
+	DataflowEndpointGroupARN       types.String                                          `tfsdk:"arn"`
+	EndpointDetails                fwtypes.ListNestedObjectValueOf[endpointDetailsModel] `tfsdk:"endpoint_details"`
+	ID                             types.String                                          `tfsdk:"id"`
+	Tags                           types.Map                                             `tfsdk:"tags"`
+	TagsAll                        types.Map                                             `tfsdk:"tags_all"`
+}
+
+type endpointDetailsModel struct {
+	AwsGroundStationAgentEndpoint fwtypes.ListNestedObjectValueOf[awsGroundStationAgentEndpointModel] `tfsdk:"aws_ground_station_agent_endpoint"`
+	Endpoint                      fwtypes.ListNestedObjectValueOf[dataflowEndpointModel]              `tfsdk:"endpoint"`
+	SecurityDetails               fwtypes.ListNestedObjectValueOf[securityDetailsModel]               `tfsdk:"security_details"`
+}
+
+type awsGroundStationAgentEndpointModel struct {
+	EgressAddress  fwtypes.ListNestedObjectValueOf[connectionDetailsModel]       `tfsdk:"egress_address"`
+	IngressAddress fwtypes.ListNestedObjectValueOf[rangedConnectionDetailsModel] `tfsdk:"ingress_address"`
+	Name           types.String                                                  `tfsdk:"name"`
+}
+
+type connectionDetailsModel struct {
+	Mtu           types.Int64                                         `tfsdk:"mtu"`
+	SocketAddress fwtypes.ListNestedObjectValueOf[socketAddressModel] `tfsdk:"socket_address"`
+}
+
+type rangedConnectionDetailsModel struct {
+	Mtu           types.Int64                                               `tfsdk:"mtu"`
+	SocketAddress fwtypes.ListNestedObjectValueOf[rangedSocketAddressModel] `tfsdk:"socket_address"`
+}
+
+type rangedSocketAddressModel struct {
+	Name      types.String                                       `tfsdk:"name"`
+	PortRange fwtypes.ListNestedObjectValueOf[integerRangeModel] `tfsdk:"port_range"`
+}
+
+type integerRangeModel struct {
+	Maximum types.Int64 `tfsdk:"maximum"`
+	Minimum types.Int64 `tfsdk:"minimum"`
+}
+
+type dataflowEndpointModel struct {
+	Address fwtypes.ListNestedObjectValueOf[socketAddressModel] `tfsdk:"address"`
+	Mtu     types.Int64                                         `tfsdk:"mtu"`
+	Name    types.String                                        `tfsdk:"name"`
+}
+
+type socketAddressModel struct {
+	Name types.String `tfsdk:"name"`
+	Port types.Int64  `tfsdk:"port"`
+}
+
+type securityDetailsModel struct {
+	RoleARN          fwtypes.ARN                      `tfsdk:"role_arn"`
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_ids"`
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationDataflowEndpointGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetDataflowEndpointGroupOutput
+	resourceName := "aws_groundstation_dataflow_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataflowEndpointGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowEndpointGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "groundstation", regexache.MustCompile(`dataflow-endpoint-group/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_post_pass_duration_seconds"),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_pre_pass_duration_seconds"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.endpoint.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.endpoint.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.endpoint.0.address.0.name", "172.10.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.endpoint.0.address.0.port", "55888"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.security_details.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_details.0.security_details.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.security_details.0.security_group_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_details.0.security_details.0.subnet_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationDataflowEndpointGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetDataflowEndpointGroupOutput
+	resourceName := "aws_groundstation_dataflow_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataflowEndpointGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowEndpointGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceDataflowEndpointGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationDataflowEndpointGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v groundstation.GetDataflowEndpointGroupOutput
+	resourceName := "aws_groundstation_dataflow_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataflowEndpointGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowEndpointGroupConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataflowEndpointGroupConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDataflowEndpointGroupConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataflowEndpointGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataflowEndpointGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_dataflow_endpoint_group" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindDataflowEndpointGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Dataflow Endpoint Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataflowEndpointGroupExists(ctx context.Context, n string, v *groundstation.GetDataflowEndpointGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindDataflowEndpointGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataflowEndpointGroupConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "groundstation.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "ec2:CreateNetworkInterface",
+        "ec2:DeleteNetworkInterface",
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DescribeSecurityGroups",
+        "ec2:DescribeSubnets",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccDataflowEndpointGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataflowEndpointGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_dataflow_endpoint_group" "test" {
+  endpoint_details {
+    endpoint {
+      name = %[1]q
+
+      address {
+        name = "172.10.0.2"
+        port = 55888
+      }
+    }
+
+    security_details {
+      role_arn           = aws_iam_role.test.arn
+      security_group_ids = [aws_security_group.test.id]
+      subnet_ids         = aws_subnet.test[*].id
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccDataflowEndpointGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDataflowEndpointGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_dataflow_endpoint_group" "test" {
+  endpoint_details {
+    endpoint {
+      name = %[1]q
+
+      address {
+        name = "172.10.0.2"
+        port = 55888
+      }
+    }
+
+    security_details {
+      role_arn           = aws_iam_role.test.arn
+      security_group_ids = [aws_security_group.test.id]
+      subnet_ids         = aws_subnet.test[*].id
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDataflowEndpointGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDataflowEndpointGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_dataflow_endpoint_group" "test" {
+  endpoint_details {
+    endpoint {
+      name = %[1]q
+
+      address {
+        name = "172.10.0.2"
+        port = 55888
+      }
+    }
+
+    security_details {
+      role_arn           = aws_iam_role.test.arn
+      security_group_ids = [aws_security_group.test.id]
+      subnet_ids         = aws_subnet.test[*].id
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationGroundStationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_groundstation_ground_stations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroundStationsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "ground_stations.#"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "satellite_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationGroundStationsDataSource_satelliteID(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_groundstation_ground_stations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroundStationsDataSourceConfig_satelliteID,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "ground_stations.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "satellite_id", "data.aws_groundstation_satellites.test", "satellites.0.satellite_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccGroundStationsDataSourceConfig_basic = `
+data "aws_groundstation_ground_stations" "test" {}
+`
+
+const testAccGroundStationsDataSourceConfig_satelliteID = `
+data "aws_groundstation_satellites" "test" {}
+
+data "aws_groundstation_ground_stations" "test" {
+  satellite_id = data.aws_groundstation_satellites.test.satellites[0].satellite_id
+}
+`
@@ -0,0 +1,410 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_groundstation_mission_profile", name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+func newMissionProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &missionProfileResource{}, nil
+}
+
+type missionProfileResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*missionProfileResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_mission_profile"
+}
+
+func (r *missionProfileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:                        framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": contactPassDurationAttribute(),
+			"contact_pre_pass_duration_seconds":  contactPassDurationAttribute(),
+			names.AttrID:                         framework.IDAttribute(),
+			"minimum_viable_contact_duration_seconds": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 21600),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z _:-]+$`), "must contain only letters, numbers, spaces, underscores (_), colons (:) and hyphens (-)"),
+				},
+			},
+			"streams_kms_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tracking_config_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dataflow_edge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEdgeModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSource: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"streams_kms_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kmsKeyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.AlsoRequires(path.MatchRoot("streams_kms_role")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_alias_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kms_alias_arn"),
+									path.MatchRelative().AtParent().AtName("kms_alias_name"),
+									path.MatchRelative().AtParent().AtName(names.AttrKMSKeyARN),
+								),
+							},
+						},
+						"kms_alias_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func contactPassDurationAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(0, 21600),
+		},
+	}
+}
+
+func (r *missionProfileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	input := &groundstation.CreateMissionProfileInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.DataflowEdges = data.expandDataflowEdges(ctx)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMissionProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Mission Profile (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.MissionProfileId)
+
+	missionProfile, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ContactPostPassDurationSeconds = fwflex.Int32ToFramework(ctx, missionProfile.ContactPostPassDurationSeconds)
+	data.ContactPrePassDurationSeconds = fwflex.Int32ToFramework(ctx, missionProfile.ContactPrePassDurationSeconds)
+	data.MissionProfileARN = fwflex.StringToFramework(ctx, missionProfile.MissionProfileArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *missionProfileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenDataflowEdges(ctx, output.DataflowEdges)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *missionProfileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.ContactPostPassDurationSeconds.Equal(old.ContactPostPassDurationSeconds) ||
+		!new.ContactPrePassDurationSeconds.Equal(old.ContactPrePassDurationSeconds) ||
+		!new.Edges.Equal(old.Edges) ||
+		!new.MinimumViableContactDurationSeconds.Equal(old.MinimumViableContactDurationSeconds) ||
+		!new.Name.Equal(old.Name) ||
+		!new.StreamsKMSKey.Equal(old.StreamsKMSKey) ||
+		!new.StreamsKMSRole.Equal(old.StreamsKMSRole) ||
+		!new.TrackingConfigARN.Equal(old.TrackingConfigARN) {
+		input := &groundstation.UpdateMissionProfileInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.DataflowEdges = new.expandDataflowEdges(ctx)
+		input.MissionProfileId = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateMissionProfile(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Mission Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *missionProfileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteMissionProfile(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *missionProfileResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findMissionProfileByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type missionProfileResourceModel struct {
+	ContactPostPassDurationSeconds      types.Int64                                        `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds       types.Int64                                        `tfsdk:"contact_pre_pass_duration_seconds"`
+	Edges                               fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] `tfsdk:"dataflow_edge"`
+	ID                                  types.String                                       `tfsdk:"id"`
+	MinimumViableContactDurationSeconds types.Int64                                        `tfsdk:"minimum_viable_contact_duration_seconds"`
+	MissionProfileARN                   types.String                                       `tfsdk:"arn"`
+	Name                                types.String                                       `tfsdk:"name"`
+	StreamsKMSKey                       fwtypes.ListNestedObjectValueOf[kmsKeyModel]       `tfsdk:"streams_kms_key"`
+	StreamsKMSRole                      fwtypes.ARN                                        `tfsdk:"streams_kms_role"`
+	Tags                                types.Map                                          `tfsdk:"tags"`
+	TagsAll                             types.Map                                          `tfsdk:"tags_all"`
+	TrackingConfigARN                   fwtypes.ARN                                        `tfsdk:"tracking_config_arn"`
+}
+
+// expandDataflowEdges returns the API representation of the dataflow edges, a list of [source, destination] pairs.
+func (data *missionProfileResourceModel) expandDataflowEdges(ctx context.Context) [][]string {
+	edges, _ := data.Edges.ToSlice(ctx)
+
+	return tfslices.ApplyToAll(edges, func(v *dataflowEdgeModel) []string {
+		return []string{v.Source.ValueString(), v.Destination.ValueString()}
+	})
+}
+
+func (data *missionProfileResourceModel) flattenDataflowEdges(ctx context.Context, apiObject [][]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	edges := tfslices.ApplyToAll(tfslices.Filter(apiObject, func(v []string) bool {
+		return len(v) == 2
+	}), func(v []string) dataflowEdgeModel {
+		return dataflowEdgeModel{
+			Destination: fwtypes.ARNValue(v[1]),
+			Source:      fwtypes.ARNValue(v[0]),
+		}
+	})
+
+	data.Edges, diags = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, edges)
+
+	return diags
+}
+
+type dataflowEdgeModel struct {
+	Destination fwtypes.ARN `tfsdk:"destination"`
+	Source      fwtypes.ARN `tfsdk:"source"`
+}
+
+type kmsKeyModel struct {
+	KMSAliasARN  fwtypes.ARN  `tfsdk:"kms_alias_arn"`
+	KMSAliasName types.String `tfsdk:"kms_alias_name"`
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+}
+
+var (
+	_ fwflex.Expander  = kmsKeyModel{}
+	_ fwflex.Flattener = &kmsKeyModel{}
+)
+
+func (m kmsKeyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.KMSAliasARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasArn{Value: m.KMSAliasARN.ValueString()}, diags
+	case !m.KMSAliasName.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasName{Value: m.KMSAliasName.ValueString()}, diags
+	case !m.KMSKeyARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsKeyArn{Value: m.KMSKeyARN.ValueString()}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *kmsKeyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.KMSAliasARN = fwtypes.ARNNull()
+	m.KMSAliasName = types.StringNull()
+	m.KMSKeyARN = fwtypes.ARNNull()
+
+	switch t := v.(type) {
+	case awstypes.KmsKeyMemberKmsAliasArn:
+		m.KMSAliasARN = fwtypes.ARNValue(t.Value)
+	case awstypes.KmsKeyMemberKmsAliasName:
+		m.KMSAliasName = types.StringValue(t.Value)
+	case awstypes.KmsKeyMemberKmsKeyArn:
+		m.KMSKeyARN = fwtypes.ARNValue(t.Value)
+	}
+
+	return diags
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Satellites")
+func newSatellitesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &satellitesDataSource{}, nil
+}
+
+type satellitesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*satellitesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_satellites"
+}
+
+func (d *satellitesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"satellites": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[satelliteListItemModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[satelliteListItemModel](ctx),
+				},
+			},
+		},
+	}
+}
+
+func (d *satellitesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data satellitesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GroundStationClient(ctx)
+
+	satellites, err := findSatellites(ctx, conn, &groundstation.ListSatellitesInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Ground Station Satellites", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, satellites, &data.Satellites)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSatellites(ctx context.Context, conn *groundstation.Client, input *groundstation.ListSatellitesInput) ([]awstypes.SatelliteListItem, error) {
+	var output []awstypes.SatelliteListItem
+
+	pages := groundstation.NewListSatellitesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Satellites...)
+	}
+
+	return output, nil
+}
+
+type satellitesDataSourceModel struct {
+	ID         types.String                                            `tfsdk:"id"`
+	Satellites fwtypes.ListNestedObjectValueOf[satelliteListItemModel] `tfsdk:"satellites"`
+}
+
+type satelliteListItemModel struct {
+	GroundStations   fwtypes.ListValueOf[types.String] `tfsdk:"ground_stations"`
+	NoradSatelliteID types.Int64                       `tfsdk:"norad_satellite_id"`
+	SatelliteARN     types.String                      `tfsdk:"satellite_arn"`
+	SatelliteID      types.String                      `tfsdk:"satellite_id"`
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="FHIR Datastore")
+func newFHIRDatastoreDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &fhirDatastoreDataSource{}, nil
+}
+
+type fhirDatastoreDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*fhirDatastoreDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_healthlake_fhir_datastore"
+}
+
+func (d *fhirDatastoreDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_type_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FHIRVersion](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"identity_provider_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[identityProviderConfigurationModel](ctx),
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"preload_data_config": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[preloadDataConfigModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[preloadDataConfigModel](ctx),
+			},
+			"sse_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sseConfigurationModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[sseConfigurationModel](ctx),
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatastoreStatus](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *fhirDatastoreDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data fhirDatastoreDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().HealthLakeClient(ctx)
+
+	name := data.DatastoreName.ValueString()
+	output, err := findFHIRDatastoreByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", name), tfresource.SingularDataSourceFindError("HealthLake FHIR Datastore", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, aws.ToString(output.DatastoreArn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for HealthLake FHIR Datastore (%s)", name), err.Error())
+
+		return
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findFHIRDatastoreByName(ctx context.Context, conn *healthlake.Client, name string) (*awstypes.DatastoreProperties, error) {
+	input := &healthlake.ListFHIRDatastoresInput{
+		Filter: &awstypes.DatastoreFilter{
+			DatastoreName: aws.String(name),
+		},
+	}
+
+	return findFHIRDatastore(ctx, conn, input, func(v *awstypes.DatastoreProperties) bool {
+		return aws.ToString(v.DatastoreName) == name && v.DatastoreStatus != awstypes.DatastoreStatusDeleted
+	})
+}
+
+type fhirDatastoreDataSourceModel struct {
+	CreatedAt                     timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	DatastoreARN                  types.String                                                        `tfsdk:"arn"`
+	DatastoreEndpoint             types.String                                                        `tfsdk:"datastore_endpoint"`
+	DatastoreID                   types.String                                                        `tfsdk:"id"`
+	DatastoreName                 types.String                                                        `tfsdk:"name"`
+	DatastoreStatus               fwtypes.StringEnum[awstypes.DatastoreStatus]                        `tfsdk:"status"`
+	DatastoreTypeVersion          fwtypes.StringEnum[awstypes.FHIRVersion]                            `tfsdk:"datastore_type_version"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigModel]             `tfsdk:"preload_data_config"`
+	SSEConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationModel]              `tfsdk:"sse_configuration"`
+	Tags                          types.Map                                                           `tfsdk:"tags"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRDatastoreDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dataSourceName := "data.aws_healthlake_fhir_datastore.test"
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreatedAt, resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, "datastore_endpoint", resourceName, "datastore_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "datastore_type_version", resourceName, "datastore_type_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "sse_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", "AWS_OWNED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrStatus, resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
+
+func testAccFHIRDatastoreDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRDatastoreConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1), `
+data "aws_healthlake_fhir_datastore" "test" {
+  name = aws_healthlake_fhir_datastore.test.name
+}
+`)
+}
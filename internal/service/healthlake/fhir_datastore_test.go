@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRDatastore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.DatastoreProperties
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, "datastore_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "datastore_type_version", "R4"),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.DatastoreProperties
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfhealthlake.ResourceFHIRDatastore, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.DatastoreProperties
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccFHIRDatastoreConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFHIRDatastoreConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthLakeFHIRDatastore_sseConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.DatastoreProperties
+	resourceName := "aws_healthlake_fhir_datastore.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRDatastoreConfig_sseConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFHIRDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "preload_data_config.0.preload_data_type", "SYNTHEA"),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sse_configuration.0.kms_encryption_config.0.cmk_type", "CUSTOMER_MANAGED_KMS_KEY"),
+					resource.TestCheckResourceAttrPair(resourceName, "sse_configuration.0.kms_encryption_config.0.kms_key_id", "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRDatastoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_healthlake_fhir_datastore" {
+				continue
+			}
+
+			_, err := tfhealthlake.FindFHIRDatastoreByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("HealthLake FHIR Datastore %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFHIRDatastoreExists(ctx context.Context, n string, v *awstypes.DatastoreProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		output, err := tfhealthlake.FindFHIRDatastoreByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+	input := &healthlake.ListFHIRDatastoresInput{}
+
+	_, err := conn.ListFHIRDatastores(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccFHIRDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+}
+`, rName)
+}
+
+func testAccFHIRDatastoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFHIRDatastoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccFHIRDatastoreConfig_sseConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_healthlake_fhir_datastore" "test" {
+  name                   = %[1]q
+  datastore_type_version = "R4"
+
+  preload_data_config {
+    preload_data_type = "SYNTHEA"
+  }
+
+  sse_configuration {
+    kms_encryption_config {
+      cmk_type   = "CUSTOMER_MANAGED_KMS_KEY"
+      kms_key_id = aws_kms_key.test.arn
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Channel")
+// @Tags(identifierAttribute="arn")
+func newChannelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &channelResource{}, nil
+}
+
+type channelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*channelResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotanalytics_channel"
+}
+
+func (r *channelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"channel_storage": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[channelStorageModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"customer_managed_s3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customerManagedS3StorageModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("customer_managed_s3"),
+									path.MatchRelative().AtParent().AtName("service_managed_s3"),
+								),
+							},
+							NestedObject: customerManagedS3StorageBlockObject(),
+						},
+						"service_managed_s3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[serviceManagedS3StorageModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+			},
+			"retention_period": retentionPeriodBlock(ctx),
+		},
+	}
+}
+
+func (r *channelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data channelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	input := &iotanalytics.CreateChannelInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateChannel(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Analytics Channel (%s)", data.ChannelName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ChannelARN = fwflex.StringToFramework(ctx, output.ChannelArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data channelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	output, err := findChannelByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Analytics Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new channelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	if !new.ChannelStorage.Equal(old.ChannelStorage) || !new.RetentionPeriod.Equal(old.RetentionPeriod) {
+		input := &iotanalytics.UpdateChannelInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateChannel(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Analytics Channel (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *channelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data channelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	_, err := conn.DeleteChannel(ctx, &iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Analytics Channel (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *channelResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findChannelByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Channel, error) {
+	input := &iotanalytics.DescribeChannelInput{
+		ChannelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeChannel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Channel, nil
+}
+
+type channelResourceModel struct {
+	ChannelARN      types.String                                          `tfsdk:"arn"`
+	ChannelName     types.String                                          `tfsdk:"name"`
+	ChannelStorage  fwtypes.ListNestedObjectValueOf[channelStorageModel]  `tfsdk:"channel_storage"`
+	ID              types.String                                          `tfsdk:"id"`
+	RetentionPeriod fwtypes.ListNestedObjectValueOf[retentionPeriodModel] `tfsdk:"retention_period"`
+	Tags            types.Map                                             `tfsdk:"tags"`
+	TagsAll         types.Map                                             `tfsdk:"tags_all"`
+}
+
+func (model *channelResourceModel) InitFromID() error {
+	model.ChannelName = model.ID
+
+	return nil
+}
+
+func (model *channelResourceModel) setID() {
+	model.ID = model.ChannelName
+}
+
+// flatten leaves channel_storage and retention_period null when they are
+// unconfigured and the API reports service-managed storage and unlimited retention.
+func (model *channelResourceModel) flatten(ctx context.Context, apiObject *awstypes.Channel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ChannelARN = fwflex.StringToFramework(ctx, apiObject.Arn)
+	model.ChannelName = fwflex.StringToFramework(ctx, apiObject.Name)
+
+	if !model.ChannelStorage.IsNull() || !isDefaultChannelStorage(apiObject.Storage) {
+		diags.Append(fwflex.Flatten(ctx, apiObject.Storage, &model.ChannelStorage)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !model.RetentionPeriod.IsNull() || !isDefaultRetentionPeriod(apiObject.RetentionPeriod) {
+		diags.Append(fwflex.Flatten(ctx, apiObject.RetentionPeriod, &model.RetentionPeriod)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func isDefaultChannelStorage(apiObject *awstypes.ChannelStorage) bool {
+	return apiObject == nil || apiObject.CustomerManagedS3 == nil
+}
+
+func isDefaultRetentionPeriod(apiObject *awstypes.RetentionPeriod) bool {
+	return apiObject == nil || (apiObject.Unlimited && apiObject.NumberOfDays == nil)
+}
+
+type channelStorageModel struct {
+	CustomerManagedS3 fwtypes.ListNestedObjectValueOf[customerManagedS3StorageModel] `tfsdk:"customer_managed_s3"`
+	ServiceManagedS3  fwtypes.ListNestedObjectValueOf[serviceManagedS3StorageModel]  `tfsdk:"service_managed_s3"`
+}
+
+type customerManagedS3StorageModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type serviceManagedS3StorageModel struct{}
+
+type retentionPeriodModel struct {
+	NumberOfDays types.Int64 `tfsdk:"number_of_days"`
+	Unlimited    types.Bool  `tfsdk:"unlimited"`
+}
+
+func customerManagedS3StorageBlockObject() schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(regexache.MustCompile(`/$`), "must end with a forward slash"),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+	}
+}
+
+func retentionPeriodBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[retentionPeriodModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"number_of_days": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+						int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("unlimited")),
+					},
+				},
+				"unlimited": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTAnalyticsChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotanalytics", fmt.Sprintf("channel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "channel_storage.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceChannel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccChannelConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_retentionPeriod(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_retentionPeriodDays(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_retentionPeriodUnlimited(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "retention_period.0.number_of_days"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.unlimited", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsChannel_customerManagedS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Channel
+	resourceName := "aws_iotanalytics_channel.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_customerManagedS3(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "channel_storage.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "channel_storage.0.customer_managed_s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "channel_storage.0.customer_managed_s3.0.key_prefix", "channel/"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "channel_storage.0.service_managed_s3.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_channel" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindChannelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Channel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelExists(ctx context.Context, n string, v *awstypes.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		output, err := tfiotanalytics.FindChannelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+	input := &iotanalytics.ListChannelsInput{}
+
+	_, err := conn.ListChannels(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+// testAccConfig_s3Storage creates an S3 bucket and an IAM role that IoT Analytics
+// can assume to read and write the bucket.
+func testAccConfig_s3Storage(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = replace(%[1]q, "_", "-")
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotanalytics.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:DeleteObject",
+      ]
+      Effect = "Allow"
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccChannelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccChannelConfig_retentionPeriodDays(rName string, days int) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+}
+`, rName, days)
+}
+
+func testAccChannelConfig_retentionPeriodUnlimited(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  retention_period {
+    unlimited = true
+  }
+}
+`, rName)
+}
+
+func testAccChannelConfig_customerManagedS3(rName string) string {
+	return acctest.ConfigCompose(testAccConfig_s3Storage(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+
+  channel_storage {
+    customer_managed_s3 {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "channel/"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -0,0 +1,745 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Dataset")
+// @Tags(identifierAttribute="arn")
+func newDatasetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &datasetResource{}, nil
+}
+
+type datasetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*datasetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotanalytics_dataset"
+}
+
+func (r *datasetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrAction: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[datasetActionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"action_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"container_action": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDatasetActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("container_action"),
+									path.MatchRelative().AtParent().AtName("query_action"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExecutionRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"image": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(255),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"resource_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[resourceConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"compute_type": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ComputeType](),
+													Required:   true,
+												},
+												"volume_size_in_gb": schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.Between(1, 50),
+													},
+												},
+											},
+										},
+									},
+									"variable": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[variableModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(50),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"double_value": schema.Float64Attribute{
+													Optional: true,
+												},
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												"string_value": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"dataset_content_version_value": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[datasetContentVersionValueModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"dataset_name": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"output_file_uri_value": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[outputFileURIValueModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"file_name": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"query_action": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sqlQueryDatasetActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"sql_query": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrFilter: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[queryFilterModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"delta_time": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[deltaTimeModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"offset_seconds": schema.Int64Attribute{
+																Required: true,
+															},
+															"time_expression": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"content_delivery_rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[datasetContentDeliveryRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"entry_name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrDestination: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[datasetContentDeliveryDestinationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"iot_events_destination_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[iotEventsDestinationConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("iot_events_destination_configuration"),
+												path.MatchRelative().AtParent().AtName("s3_destination_configuration"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"input_name": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrRoleARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+									"s3_destination_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3DestinationConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrRoleARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"glue_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[glueConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrDatabaseName: schema.StringAttribute{
+																Required: true,
+															},
+															names.AttrTableName: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"late_data_rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[lateDataRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lateDataRuleConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"delta_time_session_window_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[deltaTimeSessionWindowConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"timeout_in_minutes": schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.Between(1, 60),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retention_period": retentionPeriodBlock(ctx),
+			"trigger": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[datasetTriggerModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"dataset": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[triggeringDatasetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("dataset"),
+									path.MatchRelative().AtParent().AtName(names.AttrSchedule),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						names.AttrSchedule: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExpression: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"versioning_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[versioningConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_versions": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 1000),
+								int64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("unlimited")),
+							},
+						},
+						"unlimited": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *datasetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data datasetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	input := &iotanalytics.CreateDatasetInput{}
+	response.Diagnostics.Append(fwflex.Expand(context.WithValue(ctx, fwflex.ResourcePrefix, resPrefixDataset), data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDataset(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Analytics Dataset (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.DatasetArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *datasetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data datasetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	output, err := findDatasetByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Analytics Dataset (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *datasetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new datasetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	if !new.Actions.Equal(old.Actions) ||
+		!new.ContentDeliveryRules.Equal(old.ContentDeliveryRules) ||
+		!new.LateDataRules.Equal(old.LateDataRules) ||
+		!new.RetentionPeriod.Equal(old.RetentionPeriod) ||
+		!new.Triggers.Equal(old.Triggers) ||
+		!new.VersioningConfiguration.Equal(old.VersioningConfiguration) {
+		input := &iotanalytics.UpdateDatasetInput{}
+		response.Diagnostics.Append(fwflex.Expand(context.WithValue(ctx, fwflex.ResourcePrefix, resPrefixDataset), new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataset(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Analytics Dataset (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *datasetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data datasetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	_, err := conn.DeleteDataset(ctx, &iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Analytics Dataset (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *datasetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	resPrefixDataset = "Dataset"
+)
+
+func findDatasetByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Dataset, error) {
+	input := &iotanalytics.DescribeDatasetInput{
+		DatasetName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDataset(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dataset, nil
+}
+
+type datasetResourceModel struct {
+	Actions                 fwtypes.ListNestedObjectValueOf[datasetActionModel]              `tfsdk:"action"`
+	ARN                     types.String                                                     `tfsdk:"arn"`
+	ContentDeliveryRules    fwtypes.ListNestedObjectValueOf[datasetContentDeliveryRuleModel] `tfsdk:"content_delivery_rule"`
+	ID                      types.String                                                     `tfsdk:"id"`
+	LateDataRules           fwtypes.ListNestedObjectValueOf[lateDataRuleModel]               `tfsdk:"late_data_rule"`
+	Name                    types.String                                                     `tfsdk:"name"`
+	RetentionPeriod         fwtypes.ListNestedObjectValueOf[retentionPeriodModel]            `tfsdk:"retention_period"`
+	Tags                    types.Map                                                        `tfsdk:"tags"`
+	TagsAll                 types.Map                                                        `tfsdk:"tags_all"`
+	Triggers                fwtypes.ListNestedObjectValueOf[datasetTriggerModel]             `tfsdk:"trigger"`
+	VersioningConfiguration fwtypes.ListNestedObjectValueOf[versioningConfigurationModel]    `tfsdk:"versioning_configuration"`
+}
+
+func (model *datasetResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *datasetResourceModel) setID() {
+	model.ID = model.Name
+}
+
+// flatten keeps retention_period and versioning_configuration null when they are
+// unconfigured and the API reports the 90 day retention and single version defaults.
+func (model *datasetResourceModel) flatten(ctx context.Context, apiObject *awstypes.Dataset) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	retentionPeriod, versioningConfiguration := model.RetentionPeriod, model.VersioningConfiguration
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, model)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if retentionPeriod.IsNull() && isDefaultDatasetRetentionPeriod(apiObject.RetentionPeriod) {
+		model.RetentionPeriod = retentionPeriod
+	}
+
+	if versioningConfiguration.IsNull() && isDefaultVersioningConfiguration(apiObject.VersioningConfiguration) {
+		model.VersioningConfiguration = versioningConfiguration
+	}
+
+	return diags
+}
+
+func isDefaultDatasetRetentionPeriod(apiObject *awstypes.RetentionPeriod) bool {
+	// Dataset contents are retained for 90 days by default.
+	return isDefaultRetentionPeriod(apiObject) || (!apiObject.Unlimited && aws.ToInt32(apiObject.NumberOfDays) == 90)
+}
+
+func isDefaultVersioningConfiguration(apiObject *awstypes.VersioningConfiguration) bool {
+	return apiObject == nil || (!apiObject.Unlimited && apiObject.MaxVersions == nil)
+}
+
+type datasetActionModel struct {
+	ActionName      types.String                                                 `tfsdk:"action_name"`
+	ContainerAction fwtypes.ListNestedObjectValueOf[containerDatasetActionModel] `tfsdk:"container_action"`
+	QueryAction     fwtypes.ListNestedObjectValueOf[sqlQueryDatasetActionModel]  `tfsdk:"query_action"`
+}
+
+type containerDatasetActionModel struct {
+	ExecutionRoleARN      fwtypes.ARN                                                 `tfsdk:"execution_role_arn"`
+	Image                 types.String                                                `tfsdk:"image"`
+	ResourceConfiguration fwtypes.ListNestedObjectValueOf[resourceConfigurationModel] `tfsdk:"resource_configuration"`
+	Variables             fwtypes.ListNestedObjectValueOf[variableModel]              `tfsdk:"variable"`
+}
+
+type resourceConfigurationModel struct {
+	ComputeType    fwtypes.StringEnum[awstypes.ComputeType] `tfsdk:"compute_type"`
+	VolumeSizeInGB types.Int64                              `tfsdk:"volume_size_in_gb"`
+}
+
+type variableModel struct {
+	DatasetContentVersionValue fwtypes.ListNestedObjectValueOf[datasetContentVersionValueModel] `tfsdk:"dataset_content_version_value"`
+	DoubleValue                types.Float64                                                    `tfsdk:"double_value"`
+	Name                       types.String                                                     `tfsdk:"name"`
+	OutputFileURIValue         fwtypes.ListNestedObjectValueOf[outputFileURIValueModel]         `tfsdk:"output_file_uri_value"`
+	StringValue                types.String                                                     `tfsdk:"string_value"`
+}
+
+type datasetContentVersionValueModel struct {
+	DatasetName types.String `tfsdk:"dataset_name"`
+}
+
+type outputFileURIValueModel struct {
+	FileName types.String `tfsdk:"file_name"`
+}
+
+type sqlQueryDatasetActionModel struct {
+	Filters  fwtypes.ListNestedObjectValueOf[queryFilterModel] `tfsdk:"filter"`
+	SQLQuery types.String                                      `tfsdk:"sql_query"`
+}
+
+type queryFilterModel struct {
+	DeltaTime fwtypes.ListNestedObjectValueOf[deltaTimeModel] `tfsdk:"delta_time"`
+}
+
+type deltaTimeModel struct {
+	OffsetSeconds  types.Int64  `tfsdk:"offset_seconds"`
+	TimeExpression types.String `tfsdk:"time_expression"`
+}
+
+type datasetContentDeliveryRuleModel struct {
+	Destination fwtypes.ListNestedObjectValueOf[datasetContentDeliveryDestinationModel] `tfsdk:"destination"`
+	EntryName   types.String                                                            `tfsdk:"entry_name"`
+}
+
+type datasetContentDeliveryDestinationModel struct {
+	IotEventsDestinationConfiguration fwtypes.ListNestedObjectValueOf[iotEventsDestinationConfigurationModel] `tfsdk:"iot_events_destination_configuration"`
+	S3DestinationConfiguration        fwtypes.ListNestedObjectValueOf[s3DestinationConfigurationModel]        `tfsdk:"s3_destination_configuration"`
+}
+
+type iotEventsDestinationConfigurationModel struct {
+	InputName types.String `tfsdk:"input_name"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type s3DestinationConfigurationModel struct {
+	Bucket            types.String                                            `tfsdk:"bucket"`
+	GlueConfiguration fwtypes.ListNestedObjectValueOf[glueConfigurationModel] `tfsdk:"glue_configuration"`
+	Key               types.String                                            `tfsdk:"key"`
+	RoleARN           fwtypes.ARN                                             `tfsdk:"role_arn"`
+}
+
+type glueConfigurationModel struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	TableName    types.String `tfsdk:"table_name"`
+}
+
+type lateDataRuleModel struct {
+	RuleConfiguration fwtypes.ListNestedObjectValueOf[lateDataRuleConfigurationModel] `tfsdk:"rule_configuration"`
+	RuleName          types.String                                                    `tfsdk:"rule_name"`
+}
+
+type lateDataRuleConfigurationModel struct {
+	DeltaTimeSessionWindowConfiguration fwtypes.ListNestedObjectValueOf[deltaTimeSessionWindowConfigurationModel] `tfsdk:"delta_time_session_window_configuration"`
+}
+
+type deltaTimeSessionWindowConfigurationModel struct {
+	TimeoutInMinutes types.Int64 `tfsdk:"timeout_in_minutes"`
+}
+
+type datasetTriggerModel struct {
+	Dataset  fwtypes.ListNestedObjectValueOf[triggeringDatasetModel] `tfsdk:"dataset"`
+	Schedule fwtypes.ListNestedObjectValueOf[scheduleModel]          `tfsdk:"schedule"`
+}
+
+type triggeringDatasetModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type scheduleModel struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+type versioningConfigurationModel struct {
+	MaxVersions types.Int64 `tfsdk:"max_versions"`
+	Unlimited   types.Bool  `tfsdk:"unlimited"`
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTAnalyticsDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotanalytics", fmt.Sprintf("dataset/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_name", "query"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.container_action.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "action.0.query_action.0.sql_query"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "late_data_rule.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDataset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDataset_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Dataset
+	resourceName := "aws_iotanalytics_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_full(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.offset_seconds", "-60"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.query_action.0.filter.0.delta_time.0.time_expression", "from_unixtime(timestamp)"),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.key", "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"),
+					resource.TestCheckResourceAttrPair(resourceName, "content_delivery_rule.0.destination.0.s3_destination_configuration.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "14"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.schedule.0.expression", "rate(1 day)"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.max_versions", "5"),
+					resource.TestCheckResourceAttr(resourceName, "versioning_configuration.0.unlimited", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_dataset" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Dataset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetExists(ctx context.Context, n string, v *awstypes.Dataset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		output, err := tfiotanalytics.FindDatasetByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    action_name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccDatasetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    action_name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccDatasetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    action_name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccDatasetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatasetConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_base(rName), testAccConfig_s3Storage(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_dataset" "test" {
+  name = %[1]q
+
+  action {
+    action_name = "query"
+
+    query_action {
+      sql_query = "SELECT * FROM ${aws_iotanalytics_datastore.test.name}"
+
+      filter {
+        delta_time {
+          offset_seconds  = -60
+          time_expression = "from_unixtime(timestamp)"
+        }
+      }
+    }
+  }
+
+  content_delivery_rule {
+    destination {
+      s3_destination_configuration {
+        bucket   = aws_s3_bucket.test.bucket
+        key      = "dataset/!{iotanalytics:scheduleTime}/!{iotanalytics:versionId}.csv"
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  retention_period {
+    number_of_days = 14
+  }
+
+  trigger {
+    schedule {
+      expression = "rate(1 day)"
+    }
+  }
+
+  versioning_configuration {
+    max_versions = 5
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
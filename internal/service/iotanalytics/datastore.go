@@ -0,0 +1,600 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Datastore")
+// @Tags(identifierAttribute="arn")
+func newDatastoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &datastoreResource{}, nil
+}
+
+type datastoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*datastoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotanalytics_datastore"
+}
+
+func (r *datastoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"datastore_partitions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[datastorePartitionsModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"partition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[datastorePartitionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(25),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"attribute_partition": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[attributePartitionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("attribute_partition"),
+												path.MatchRelative().AtParent().AtName("timestamp_partition"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"attribute_name": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"timestamp_partition": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[timestampPartitionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"attribute_name": schema.StringAttribute{
+													Required: true,
+												},
+												"timestamp_format": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"datastore_storage": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[datastoreStorageModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"customer_managed_s3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customerManagedS3StorageModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("customer_managed_s3"),
+									path.MatchRelative().AtParent().AtName("iot_sitewise_multi_layer_storage"),
+									path.MatchRelative().AtParent().AtName("service_managed_s3"),
+								),
+							},
+							NestedObject: customerManagedS3StorageBlockObject(),
+						},
+						"iot_sitewise_multi_layer_storage": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[iotSiteWiseMultiLayerStorageModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"customer_managed_s3_storage": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[iotSiteWiseCustomerManagedS3StorageModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												"key_prefix": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_managed_s3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[serviceManagedS3StorageModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+					},
+				},
+			},
+			"file_format_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[fileFormatConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"json_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[jsonConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("json_configuration"),
+									path.MatchRelative().AtParent().AtName("parquet_configuration"),
+								),
+							},
+						},
+						"parquet_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[parquetConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"schema_definition": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[schemaDefinitionModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"column": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[columnModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeBetween(1, 100),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Required: true,
+															},
+															names.AttrType: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"retention_period": retentionPeriodBlock(ctx),
+		},
+	}
+}
+
+func (r *datastoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data datastoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	input := &iotanalytics.CreateDatastoreInput{}
+	response.Diagnostics.Append(fwflex.Expand(context.WithValue(ctx, fwflex.ResourcePrefix, resPrefixDatastore), data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDatastore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Analytics Datastore (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.DatastoreArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *datastoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data datastoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	output, err := findDatastoreByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Analytics Datastore (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *datastoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new datastoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	if !new.RetentionPeriod.Equal(old.RetentionPeriod) || !new.Storage.Equal(old.Storage) {
+		input := &iotanalytics.UpdateDatastoreInput{}
+		response.Diagnostics.Append(fwflex.Expand(context.WithValue(ctx, fwflex.ResourcePrefix, resPrefixDatastore), new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDatastore(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Analytics Datastore (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *datastoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data datastoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	_, err := conn.DeleteDatastore(ctx, &iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Analytics Datastore (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *datastoreResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	resPrefixDatastore = "Datastore"
+)
+
+func findDatastoreByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Datastore, error) {
+	input := &iotanalytics.DescribeDatastoreInput{
+		DatastoreName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDatastore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Datastore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Datastore, nil
+}
+
+type datastoreResourceModel struct {
+	ARN                     types.String                                                  `tfsdk:"arn"`
+	DatastorePartitions     fwtypes.ListNestedObjectValueOf[datastorePartitionsModel]     `tfsdk:"datastore_partitions"`
+	FileFormatConfiguration fwtypes.ListNestedObjectValueOf[fileFormatConfigurationModel] `tfsdk:"file_format_configuration"`
+	ID                      types.String                                                  `tfsdk:"id"`
+	Name                    types.String                                                  `tfsdk:"name"`
+	RetentionPeriod         fwtypes.ListNestedObjectValueOf[retentionPeriodModel]         `tfsdk:"retention_period"`
+	Storage                 fwtypes.ListNestedObjectValueOf[datastoreStorageModel]        `tfsdk:"datastore_storage"`
+	Tags                    types.Map                                                     `tfsdk:"tags"`
+	TagsAll                 types.Map                                                     `tfsdk:"tags_all"`
+}
+
+func (model *datastoreResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *datastoreResourceModel) setID() {
+	model.ID = model.Name
+}
+
+// flatten keeps file_format_configuration, retention_period and datastore_storage
+// null when they are unconfigured and the API reports the JSON, unlimited and
+// service-managed S3 defaults.
+func (model *datastoreResourceModel) flatten(ctx context.Context, apiObject *awstypes.Datastore) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fileFormatConfiguration, retentionPeriod, storage := model.FileFormatConfiguration, model.RetentionPeriod, model.Storage
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, model)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if fileFormatConfiguration.IsNull() && isDefaultFileFormatConfiguration(apiObject.FileFormatConfiguration) {
+		model.FileFormatConfiguration = fileFormatConfiguration
+	}
+
+	if retentionPeriod.IsNull() && isDefaultRetentionPeriod(apiObject.RetentionPeriod) {
+		model.RetentionPeriod = retentionPeriod
+	}
+
+	if storage.IsNull() && isDefaultDatastoreStorage(apiObject.Storage) {
+		model.Storage = storage
+	}
+
+	return diags
+}
+
+func isDefaultFileFormatConfiguration(apiObject *awstypes.FileFormatConfiguration) bool {
+	return apiObject == nil || apiObject.ParquetConfiguration == nil
+}
+
+func isDefaultDatastoreStorage(apiObject awstypes.DatastoreStorage) bool {
+	switch apiObject.(type) {
+	case nil, *awstypes.DatastoreStorageMemberServiceManagedS3:
+		return true
+	}
+
+	return false
+}
+
+type datastorePartitionsModel struct {
+	Partitions fwtypes.ListNestedObjectValueOf[datastorePartitionModel] `tfsdk:"partition"`
+}
+
+type datastorePartitionModel struct {
+	AttributePartition fwtypes.ListNestedObjectValueOf[attributePartitionModel] `tfsdk:"attribute_partition"`
+	TimestampPartition fwtypes.ListNestedObjectValueOf[timestampPartitionModel] `tfsdk:"timestamp_partition"`
+}
+
+type attributePartitionModel struct {
+	AttributeName types.String `tfsdk:"attribute_name"`
+}
+
+type timestampPartitionModel struct {
+	AttributeName   types.String `tfsdk:"attribute_name"`
+	TimestampFormat types.String `tfsdk:"timestamp_format"`
+}
+
+type datastoreStorageModel struct {
+	CustomerManagedS3            fwtypes.ListNestedObjectValueOf[customerManagedS3StorageModel]     `tfsdk:"customer_managed_s3"`
+	IotSiteWiseMultiLayerStorage fwtypes.ListNestedObjectValueOf[iotSiteWiseMultiLayerStorageModel] `tfsdk:"iot_sitewise_multi_layer_storage"`
+	ServiceManagedS3             fwtypes.ListNestedObjectValueOf[serviceManagedS3StorageModel]      `tfsdk:"service_managed_s3"`
+}
+
+var (
+	_ fwflex.Expander  = datastoreStorageModel{}
+	_ fwflex.Flattener = &datastoreStorageModel{}
+)
+
+func (m datastoreStorageModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CustomerManagedS3.IsNull():
+		customerManagedS3Data, d := m.CustomerManagedS3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.DatastoreStorageMemberCustomerManagedS3
+		diags.Append(fwflex.Expand(ctx, customerManagedS3Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.IotSiteWiseMultiLayerStorage.IsNull():
+		iotSiteWiseMultiLayerStorageData, d := m.IotSiteWiseMultiLayerStorage.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.DatastoreStorageMemberIotSiteWiseMultiLayerStorage
+		diags.Append(fwflex.Expand(ctx, iotSiteWiseMultiLayerStorageData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.ServiceManagedS3.IsNull():
+		return &awstypes.DatastoreStorageMemberServiceManagedS3{}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *datastoreStorageModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.CustomerManagedS3 = fwtypes.NewListNestedObjectValueOfNull[customerManagedS3StorageModel](ctx)
+	m.IotSiteWiseMultiLayerStorage = fwtypes.NewListNestedObjectValueOfNull[iotSiteWiseMultiLayerStorageModel](ctx)
+	m.ServiceManagedS3 = fwtypes.NewListNestedObjectValueOfNull[serviceManagedS3StorageModel](ctx)
+
+	switch t := v.(type) {
+	case awstypes.DatastoreStorageMemberCustomerManagedS3:
+		var model customerManagedS3StorageModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CustomerManagedS3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.DatastoreStorageMemberIotSiteWiseMultiLayerStorage:
+		var model iotSiteWiseMultiLayerStorageModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.IotSiteWiseMultiLayerStorage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.DatastoreStorageMemberServiceManagedS3:
+		m.ServiceManagedS3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &serviceManagedS3StorageModel{})
+
+		return diags
+	}
+
+	return diags
+}
+
+type iotSiteWiseMultiLayerStorageModel struct {
+	CustomerManagedS3Storage fwtypes.ListNestedObjectValueOf[iotSiteWiseCustomerManagedS3StorageModel] `tfsdk:"customer_managed_s3_storage"`
+}
+
+type iotSiteWiseCustomerManagedS3StorageModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+}
+
+type fileFormatConfigurationModel struct {
+	JSONConfiguration    fwtypes.ListNestedObjectValueOf[jsonConfigurationModel]    `tfsdk:"json_configuration"`
+	ParquetConfiguration fwtypes.ListNestedObjectValueOf[parquetConfigurationModel] `tfsdk:"parquet_configuration"`
+}
+
+type jsonConfigurationModel struct{}
+
+type parquetConfigurationModel struct {
+	SchemaDefinition fwtypes.ListNestedObjectValueOf[schemaDefinitionModel] `tfsdk:"schema_definition"`
+}
+
+type schemaDefinitionModel struct {
+	Columns fwtypes.ListNestedObjectValueOf[columnModel] `tfsdk:"column"`
+}
+
+type columnModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTAnalyticsDatastore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotanalytics", fmt.Sprintf("datastore/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "datastore_storage.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourceDatastore, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatastoreConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDatastoreConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "datastore_storage.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccDatastoreConfig_customerManagedS3(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "datastore_storage.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "datastore_storage.0.customer_managed_s3.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_storage.0.customer_managed_s3.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "datastore_storage.0.customer_managed_s3.0.key_prefix", "datastore/"),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_storage.0.customer_managed_s3.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "retention_period.0.number_of_days", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsDatastore_parquet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Datastore
+	resourceName := "aws_iotanalytics_datastore.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreConfig_parquet(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatastoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "datastore_partitions.0.partition.0.attribute_partition.0.attribute_name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.name", "device_id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.0.type", "string"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.1.name", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "file_format_configuration.0.parquet_configuration.0.schema_definition.0.column.1.type", "double"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatastoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_datastore" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Datastore %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDatastoreExists(ctx context.Context, n string, v *awstypes.Datastore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		output, err := tfiotanalytics.FindDatastoreByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatastoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccDatastoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDatastoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccDatastoreConfig_customerManagedS3(rName string, days int) string {
+	return acctest.ConfigCompose(testAccConfig_s3Storage(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  datastore_storage {
+    customer_managed_s3 {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "datastore/"
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+
+  retention_period {
+    number_of_days = %[2]d
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, days))
+}
+
+func testAccDatastoreConfig_parquet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+
+  datastore_partitions {
+    partition {
+      attribute_partition {
+        attribute_name = "device_id"
+      }
+    }
+  }
+
+  file_format_configuration {
+    parquet_configuration {
+      schema_definition {
+        column {
+          name = "device_id"
+          type = "string"
+        }
+
+        column {
+          name = "temperature"
+          type = "double"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+// Exports for use in tests only.
+var (
+	ResourceChannel   = newChannelResource
+	ResourceDataset   = newDatasetResource
+	ResourceDatastore = newDatastoreResource
+	ResourcePipeline  = newPipelineResource
+
+	FindChannelByName   = findChannelByName
+	FindDatasetByName   = findDatasetByName
+	FindDatastoreByName = findDatastoreByName
+	FindPipelineByName  = findPipelineByName
+)
@@ -0,0 +1,514 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotanalytics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Pipeline")
+// @Tags(identifierAttribute="arn")
+func newPipelineResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &pipelineResource{}, nil
+}
+
+type pipelineResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*pipelineResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotanalytics_pipeline"
+}
+
+func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	activityNameAttribute := schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 128),
+		},
+	}
+	activityNextAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 128),
+		},
+	}
+	enrichActivityBlockObject := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"attribute":    schema.StringAttribute{Required: true},
+			names.AttrName: activityNameAttribute,
+			"next":         activityNextAttribute,
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"thing_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	attributesActivityBlockObject := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAttributes: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			names.AttrName: activityNameAttribute,
+			"next":         activityNextAttribute,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_]+$`), "must contain only alphanumeric characters and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"activity": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pipelineActivityModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 25),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"add_attributes": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[addAttributesActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("add_attributes"),
+									path.MatchRelative().AtParent().AtName("channel"),
+									path.MatchRelative().AtParent().AtName("datastore"),
+									path.MatchRelative().AtParent().AtName("device_registry_enrich"),
+									path.MatchRelative().AtParent().AtName("device_shadow_enrich"),
+									path.MatchRelative().AtParent().AtName(names.AttrFilter),
+									path.MatchRelative().AtParent().AtName("lambda"),
+									path.MatchRelative().AtParent().AtName("math"),
+									path.MatchRelative().AtParent().AtName("remove_attributes"),
+									path.MatchRelative().AtParent().AtName("select_attributes"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAttributes: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.Map{
+											mapvalidator.SizeBetween(1, 50),
+										},
+									},
+									names.AttrName: activityNameAttribute,
+									"next":         activityNextAttribute,
+								},
+							},
+						},
+						"channel": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[channelActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"channel_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: activityNameAttribute,
+									"next":         activityNextAttribute,
+								},
+							},
+						},
+						"datastore": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[datastoreActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"datastore_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: activityNameAttribute,
+								},
+							},
+						},
+						"device_registry_enrich": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[deviceRegistryEnrichActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: enrichActivityBlockObject,
+						},
+						"device_shadow_enrich": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[deviceShadowEnrichActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: enrichActivityBlockObject,
+						},
+						names.AttrFilter: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[filterActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrFilter: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: activityNameAttribute,
+									"next":         activityNextAttribute,
+								},
+							},
+						},
+						"lambda": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"batch_size": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 1000),
+										},
+									},
+									"lambda_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: activityNameAttribute,
+									"next":         activityNextAttribute,
+								},
+							},
+						},
+						"math": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[mathActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"attribute": schema.StringAttribute{
+										Required: true,
+									},
+									"math": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrName: activityNameAttribute,
+									"next":         activityNextAttribute,
+								},
+							},
+						},
+						"remove_attributes": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[removeAttributesActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: attributesActivityBlockObject,
+						},
+						"select_attributes": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[selectAttributesActivityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: attributesActivityBlockObject,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pipelineResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	input := &iotanalytics.CreatePipelineInput{}
+	response.Diagnostics.Append(fwflex.Expand(context.WithValue(ctx, fwflex.ResourcePrefix, resPrefixPipeline), data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePipeline(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Analytics Pipeline (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.PipelineArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pipelineResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pipelineResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	output, err := findPipelineByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Analytics Pipeline (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pipelineResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	if !new.Activities.Equal(old.Activities) {
+		input := &iotanalytics.UpdatePipelineInput{}
+		response.Diagnostics.Append(fwflex.Expand(context.WithValue(ctx, fwflex.ResourcePrefix, resPrefixPipeline), new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePipeline(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Analytics Pipeline (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pipelineResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pipelineResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTAnalyticsClient(ctx)
+
+	_, err := conn.DeletePipeline(ctx, &iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Analytics Pipeline (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pipelineResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	resPrefixPipeline = "Pipeline"
+)
+
+func findPipelineByName(ctx context.Context, conn *iotanalytics.Client, name string) (*awstypes.Pipeline, error) {
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(name),
+	}
+
+	output, err := conn.DescribePipeline(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Pipeline, nil
+}
+
+type pipelineResourceModel struct {
+	Activities fwtypes.ListNestedObjectValueOf[pipelineActivityModel] `tfsdk:"activity"`
+	ARN        types.String                                           `tfsdk:"arn"`
+	ID         types.String                                           `tfsdk:"id"`
+	Name       types.String                                           `tfsdk:"name"`
+	Tags       types.Map                                              `tfsdk:"tags"`
+	TagsAll    types.Map                                              `tfsdk:"tags_all"`
+}
+
+func (model *pipelineResourceModel) InitFromID() error {
+	model.Name = model.ID
+
+	return nil
+}
+
+func (model *pipelineResourceModel) setID() {
+	model.ID = model.Name
+}
+
+type pipelineActivityModel struct {
+	AddAttributes        fwtypes.ListNestedObjectValueOf[addAttributesActivityModel]        `tfsdk:"add_attributes"`
+	Channel              fwtypes.ListNestedObjectValueOf[channelActivityModel]              `tfsdk:"channel"`
+	Datastore            fwtypes.ListNestedObjectValueOf[datastoreActivityModel]            `tfsdk:"datastore"`
+	DeviceRegistryEnrich fwtypes.ListNestedObjectValueOf[deviceRegistryEnrichActivityModel] `tfsdk:"device_registry_enrich"`
+	DeviceShadowEnrich   fwtypes.ListNestedObjectValueOf[deviceShadowEnrichActivityModel]   `tfsdk:"device_shadow_enrich"`
+	Filter               fwtypes.ListNestedObjectValueOf[filterActivityModel]               `tfsdk:"filter"`
+	Lambda               fwtypes.ListNestedObjectValueOf[lambdaActivityModel]               `tfsdk:"lambda"`
+	Math                 fwtypes.ListNestedObjectValueOf[mathActivityModel]                 `tfsdk:"math"`
+	RemoveAttributes     fwtypes.ListNestedObjectValueOf[removeAttributesActivityModel]     `tfsdk:"remove_attributes"`
+	SelectAttributes     fwtypes.ListNestedObjectValueOf[selectAttributesActivityModel]     `tfsdk:"select_attributes"`
+}
+
+type addAttributesActivityModel struct {
+	Attributes fwtypes.MapValueOf[types.String] `tfsdk:"attributes"`
+	Name       types.String                     `tfsdk:"name"`
+	Next       types.String                     `tfsdk:"next"`
+}
+
+type channelActivityModel struct {
+	ChannelName types.String `tfsdk:"channel_name"`
+	Name        types.String `tfsdk:"name"`
+	Next        types.String `tfsdk:"next"`
+}
+
+type datastoreActivityModel struct {
+	DatastoreName types.String `tfsdk:"datastore_name"`
+	Name          types.String `tfsdk:"name"`
+}
+
+type deviceRegistryEnrichActivityModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Name      types.String `tfsdk:"name"`
+	Next      types.String `tfsdk:"next"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+	ThingName types.String `tfsdk:"thing_name"`
+}
+
+type deviceShadowEnrichActivityModel = deviceRegistryEnrichActivityModel
+
+type filterActivityModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Name   types.String `tfsdk:"name"`
+	Next   types.String `tfsdk:"next"`
+}
+
+type lambdaActivityModel struct {
+	BatchSize  types.Int64  `tfsdk:"batch_size"`
+	LambdaName types.String `tfsdk:"lambda_name"`
+	Name       types.String `tfsdk:"name"`
+	Next       types.String `tfsdk:"next"`
+}
+
+type mathActivityModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Math      types.String `tfsdk:"math"`
+	Name      types.String `tfsdk:"name"`
+	Next      types.String `tfsdk:"next"`
+}
+
+type removeAttributesActivityModel struct {
+	Attributes fwtypes.ListValueOf[types.String] `tfsdk:"attributes"`
+	Name       types.String                      `tfsdk:"name"`
+	Next       types.String                      `tfsdk:"next"`
+}
+
+type selectAttributesActivityModel = removeAttributesActivityModel
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotanalytics_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotanalytics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotanalytics "github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTAnalyticsPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotanalytics", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.0.channel.0.channel_name", "aws_iotanalytics_channel.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.name", "channel_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "datastore_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "activity.1.datastore.0.datastore_name", "aws_iotanalytics_pipeline.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.datastore.0.name", "datastore_activity"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotanalytics.ResourcePipeline, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTAnalyticsPipeline_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTAnalyticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccPipelineConfig_activities(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activity.#", "6"),
+					resource.TestCheckResourceAttr(resourceName, "activity.0.channel.0.next", "filter_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.filter", "temperature > 0"),
+					resource.TestCheckResourceAttr(resourceName, "activity.1.filter.0.next", "math_activity"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "activity.2.math.0.math", "temperature * 1.8 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "activity.3.add_attributes.0.attributes.device_id", "id"),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "activity.4.remove_attributes.0.attributes.0", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "activity.5.datastore.0.name", "datastore_activity"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotanalytics_pipeline" {
+				continue
+			}
+
+			_, err := tfiotanalytics.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Analytics Pipeline %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPipelineExists(ctx context.Context, n string, v *awstypes.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTAnalyticsClient(ctx)
+
+		output, err := tfiotanalytics.FindPipelineByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipelineConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccPipelineConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccPipelineConfig_activities(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  activity {
+    channel {
+      channel_name = aws_iotanalytics_channel.test.name
+      name         = "channel_activity"
+      next         = "filter_activity"
+    }
+  }
+
+  activity {
+    filter {
+      filter = "temperature > 0"
+      name   = "filter_activity"
+      next   = "math_activity"
+    }
+  }
+
+  activity {
+    math {
+      attribute = "temperature_f"
+      math      = "temperature * 1.8 + 32"
+      name      = "math_activity"
+      next      = "add_attributes_activity"
+    }
+  }
+
+  activity {
+    add_attributes {
+      attributes = {
+        device_id = "id"
+      }
+      name = "add_attributes_activity"
+      next = "remove_attributes_activity"
+    }
+  }
+
+  activity {
+    remove_attributes {
+      attributes = ["temperature"]
+      name       = "remove_attributes_activity"
+      next       = "datastore_activity"
+    }
+  }
+
+  activity {
+    datastore {
+      datastore_name = aws_iotanalytics_datastore.test.name
+      name           = "datastore_activity"
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,567 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Alarm Model")
+// @Tags(identifierAttribute="arn")
+func newAlarmModelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &alarmModelResource{}, nil
+}
+
+type alarmModelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*alarmModelResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotevents_alarm_model"
+}
+
+func (r *alarmModelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alarm_event_actions": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"alarm_notification": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKey: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"severity": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"alarm_capabilities": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[alarmCapabilitiesModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"acknowledge_flow": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[acknowledgeFlowModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"initialization_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[initializationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"disabled_on_initialization": schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"alarm_rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[alarmRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"simple_rule": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[simpleRuleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"comparison_operator": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ComparisonOperator](),
+										Required:   true,
+									},
+									"input_property": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 512),
+										},
+									},
+									"threshold": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 512),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *alarmModelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data alarmModelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	name := data.AlarmModelName.ValueString()
+	input := &iotevents.CreateAlarmModelInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	var err error
+	input.AlarmEventActions, input.AlarmNotification, err = data.expandDocuments()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Events Alarm Model (%s)", name), err.Error())
+
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAlarmModel(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Events Alarm Model (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AlarmModelARN = fwflex.StringToFramework(ctx, output.AlarmModelArn)
+	data.setID()
+
+	outputWait, err := waitAlarmModelActive(ctx, conn, data.ID.ValueString(), alarmModelTimeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Alarm Model (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AlarmModelVersion = fwflex.StringToFramework(ctx, outputWait.AlarmModelVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *alarmModelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data alarmModelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	output, err := findAlarmModelByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Events Alarm Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	alarmCapabilities := data.AlarmCapabilities
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API returns default alarm capabilities when none are configured.
+	if alarmCapabilities.IsNull() && isDefaultAlarmCapabilities(output.AlarmCapabilities) {
+		data.AlarmCapabilities = alarmCapabilities
+	}
+
+	if err := data.flattenDocuments(output.AlarmEventActions, output.AlarmNotification); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Events Alarm Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *alarmModelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new alarmModelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	if !new.AlarmCapabilities.Equal(old.AlarmCapabilities) ||
+		!new.EventActionsDocument.Equal(old.EventActionsDocument) ||
+		!new.AlarmModelDescription.Equal(old.AlarmModelDescription) ||
+		!new.NotificationDocument.Equal(old.NotificationDocument) ||
+		!new.AlarmRule.Equal(old.AlarmRule) ||
+		!new.RoleARN.Equal(old.RoleARN) ||
+		!new.Severity.Equal(old.Severity) {
+		input := &iotevents.UpdateAlarmModelInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		var err error
+		input.AlarmEventActions, input.AlarmNotification, err = new.expandDocuments()
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Events Alarm Model (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		_, err = conn.UpdateAlarmModel(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Events Alarm Model (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitAlarmModelActive(ctx, conn, new.ID.ValueString(), alarmModelTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Alarm Model (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.AlarmModelVersion = fwflex.StringToFramework(ctx, output.AlarmModelVersion)
+	} else {
+		new.AlarmModelVersion = old.AlarmModelVersion
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *alarmModelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data alarmModelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	_, err := conn.DeleteAlarmModel(ctx, &iotevents.DeleteAlarmModelInput{
+		AlarmModelName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Events Alarm Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAlarmModelDeleted(ctx, conn, data.ID.ValueString(), alarmModelTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Alarm Model (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *alarmModelResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	alarmModelTimeout = 5 * time.Minute
+)
+
+func findAlarmModelByName(ctx context.Context, conn *iotevents.Client, name string) (*iotevents.DescribeAlarmModelOutput, error) {
+	input := &iotevents.DescribeAlarmModelInput{
+		AlarmModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAlarmModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAlarmModelActive(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AlarmModelVersionStatusActivating),
+		Target:  enum.Slice(awstypes.AlarmModelVersionStatusActive),
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		if output.Status == awstypes.AlarmModelVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAlarmModelDeleted(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AlarmModelVersionStatusActive, awstypes.AlarmModelVersionStatusActivating, awstypes.AlarmModelVersionStatusInactive),
+		Target:  []string{},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type alarmModelResourceModel struct {
+	AlarmCapabilities     fwtypes.ListNestedObjectValueOf[alarmCapabilitiesModel] `tfsdk:"alarm_capabilities"`
+	AlarmModelARN         types.String                                            `tfsdk:"arn"`
+	AlarmModelDescription types.String                                            `tfsdk:"description"`
+	AlarmModelName        types.String                                            `tfsdk:"name"`
+	AlarmModelVersion     types.String                                            `tfsdk:"version"`
+	AlarmRule             fwtypes.ListNestedObjectValueOf[alarmRuleModel]         `tfsdk:"alarm_rule"`
+	EventActionsDocument  jsontypes.Normalized                                    `tfsdk:"alarm_event_actions"`
+	ID                    types.String                                            `tfsdk:"id"`
+	Key                   types.String                                            `tfsdk:"key"`
+	NotificationDocument  jsontypes.Normalized                                    `tfsdk:"alarm_notification"`
+	RoleARN               fwtypes.ARN                                             `tfsdk:"role_arn"`
+	Severity              types.Int64                                             `tfsdk:"severity"`
+	Tags                  types.Map                                               `tfsdk:"tags"`
+	TagsAll               types.Map                                               `tfsdk:"tags_all"`
+}
+
+func (model *alarmModelResourceModel) InitFromID() error {
+	model.AlarmModelName = model.ID
+
+	return nil
+}
+
+func (model *alarmModelResourceModel) setID() {
+	model.ID = model.AlarmModelName
+}
+
+func (model *alarmModelResourceModel) expandDocuments() (*awstypes.AlarmEventActions, *awstypes.AlarmNotification, error) {
+	var alarmEventActions *awstypes.AlarmEventActions
+	var alarmNotification *awstypes.AlarmNotification
+	var err error
+
+	if !model.EventActionsDocument.IsNull() {
+		alarmEventActions, err = expandJSONDocument[awstypes.AlarmEventActions](model.EventActionsDocument)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding alarm_event_actions: %w", err)
+		}
+	}
+
+	if !model.NotificationDocument.IsNull() {
+		alarmNotification, err = expandJSONDocument[awstypes.AlarmNotification](model.NotificationDocument)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding alarm_notification: %w", err)
+		}
+	}
+
+	return alarmEventActions, alarmNotification, nil
+}
+
+func (model *alarmModelResourceModel) flattenDocuments(alarmEventActions *awstypes.AlarmEventActions, alarmNotification *awstypes.AlarmNotification) error {
+	var err error
+
+	model.EventActionsDocument, err = flattenJSONDocument(model.EventActionsDocument, alarmEventActions)
+	if err != nil {
+		return fmt.Errorf("encoding alarm_event_actions: %w", err)
+	}
+
+	model.NotificationDocument, err = flattenJSONDocument(model.NotificationDocument, alarmNotification)
+	if err != nil {
+		return fmt.Errorf("encoding alarm_notification: %w", err)
+	}
+
+	return nil
+}
+
+func isDefaultAlarmCapabilities(apiObject *awstypes.AlarmCapabilities) bool {
+	if apiObject == nil {
+		return true
+	}
+
+	if v := apiObject.AcknowledgeFlow; v != nil && !aws.ToBool(v.Enabled) {
+		return false
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil && !aws.ToBool(v.DisabledOnInitialization) {
+		return false
+	}
+
+	return true
+}
+
+type alarmCapabilitiesModel struct {
+	AcknowledgeFlow             fwtypes.ListNestedObjectValueOf[acknowledgeFlowModel]             `tfsdk:"acknowledge_flow"`
+	InitializationConfiguration fwtypes.ListNestedObjectValueOf[initializationConfigurationModel] `tfsdk:"initialization_configuration"`
+}
+
+type acknowledgeFlowModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type initializationConfigurationModel struct {
+	DisabledOnInitialization types.Bool `tfsdk:"disabled_on_initialization"`
+}
+
+type alarmRuleModel struct {
+	SimpleRule fwtypes.ListNestedObjectValueOf[simpleRuleModel] `tfsdk:"simple_rule"`
+}
+
+type simpleRuleModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ComparisonOperator] `tfsdk:"comparison_operator"`
+	InputProperty      types.String                                    `tfsdk:"input_property"`
+	Threshold          types.String                                    `tfsdk:"threshold"`
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsAlarmModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	resourceName := "aws_iotevents_alarm_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "GREATER", "70"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.#", acctest.Ct0),
+					resource.TestCheckNoResourceAttr(resourceName, "alarm_event_actions"),
+					resource.TestCheckNoResourceAttr(resourceName, "alarm_notification"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.input_property", "$input.temperature_input.temperature"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "70"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotevents", fmt.Sprintf("alarmModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	resourceName := "aws_iotevents_alarm_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "GREATER", "70"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceAlarmModel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotevents.DescribeAlarmModelOutput
+	resourceName := "aws_iotevents_alarm_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "GREATER", "70"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccAlarmModelConfig_full(rName, "LESS", "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.acknowledge_flow.0.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.initialization_configuration.0.disabled_on_initialization", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "alarm_event_actions"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "LESS"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "10"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "severity", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"alarm_event_actions"},
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_alarm_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Alarm Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlarmModelExists(ctx context.Context, n string, v *iotevents.DescribeAlarmModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		output, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAlarmModelConfig_basic(rName, comparisonOperator, threshold string) string {
+	return acctest.ConfigCompose(testAccConfig_role(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = %[2]q
+      input_property      = "$input.temperature_input.temperature"
+      threshold           = %[3]q
+    }
+  }
+}
+`, rName, comparisonOperator, threshold))
+}
+
+func testAccAlarmModelConfig_full(rName, comparisonOperator, threshold string) string {
+	return acctest.ConfigCompose(testAccConfig_role(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotevents_alarm_model" "test" {
+  name        = %[1]q
+  description = "updated"
+  role_arn    = aws_iam_role.test.arn
+  severity    = 2
+
+  alarm_capabilities {
+    acknowledge_flow {
+      enabled = false
+    }
+
+    initialization_configuration {
+      disabled_on_initialization = false
+    }
+  }
+
+  alarm_event_actions = jsonencode({
+    alarmActions = [{
+      sns = {
+        targetArn = aws_sns_topic.test.arn
+      }
+    }]
+  })
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = %[2]q
+      input_property      = "$input.temperature_input.temperature"
+      threshold           = %[3]q
+    }
+  }
+}
+`, rName, comparisonOperator, threshold))
+}
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Detector Model")
+// @Tags(identifierAttribute="arn")
+func newDetectorModelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &detectorModelResource{}, nil
+}
+
+type detectorModelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*detectorModelResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotevents_detector_model"
+}
+
+func (r *detectorModelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"detector_model_definition": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			"evaluation_method": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationMethod](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKey: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *detectorModelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data detectorModelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	name := data.DetectorModelName.ValueString()
+	input := &iotevents.CreateDetectorModelInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	definition, err := expandJSONDocument[awstypes.DetectorModelDefinition](data.Definition)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("detector_model_definition"), "decoding JSON", err.Error())
+
+		return
+	}
+	input.DetectorModelDefinition = definition
+	input.Tags = getTagsIn(ctx)
+
+	_, err = conn.CreateDetectorModel(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Events Detector Model (%s)", name), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	output, err := waitDetectorModelActive(ctx, conn, data.ID.ValueString(), detectorModelTimeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Detector Model (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.DetectorModelARN = fwflex.StringToFramework(ctx, output.DetectorModelConfiguration.DetectorModelArn)
+	data.DetectorModelVersion = fwflex.StringToFramework(ctx, output.DetectorModelConfiguration.DetectorModelVersion)
+	data.EvaluationMethod = fwtypes.StringEnumValue(output.DetectorModelConfiguration.EvaluationMethod)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *detectorModelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data detectorModelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	output, err := findDetectorModelByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Events Detector Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.DetectorModelConfiguration, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition, err = flattenJSONDocument(data.Definition, output.DetectorModelDefinition)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("flattening IoT Events Detector Model (%s) definition", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *detectorModelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new detectorModelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) ||
+		!new.DetectorModelDescription.Equal(old.DetectorModelDescription) ||
+		!new.EvaluationMethod.Equal(old.EvaluationMethod) ||
+		!new.RoleARN.Equal(old.RoleARN) {
+		input := &iotevents.UpdateDetectorModelInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		definition, err := expandJSONDocument[awstypes.DetectorModelDefinition](new.Definition)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("detector_model_definition"), "decoding JSON", err.Error())
+
+			return
+		}
+		input.DetectorModelDefinition = definition
+
+		_, err = conn.UpdateDetectorModel(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Events Detector Model (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := waitDetectorModelActive(ctx, conn, new.ID.ValueString(), detectorModelTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Detector Model (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DetectorModelVersion = fwflex.StringToFramework(ctx, output.DetectorModelConfiguration.DetectorModelVersion)
+		new.EvaluationMethod = fwtypes.StringEnumValue(output.DetectorModelConfiguration.EvaluationMethod)
+	} else {
+		new.DetectorModelVersion = old.DetectorModelVersion
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *detectorModelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data detectorModelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	_, err := conn.DeleteDetectorModel(ctx, &iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Events Detector Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDetectorModelDeleted(ctx, conn, data.ID.ValueString(), detectorModelTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Detector Model (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *detectorModelResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	detectorModelTimeout = 5 * time.Minute
+)
+
+func findDetectorModelByName(ctx context.Context, conn *iotevents.Client, name string) (*awstypes.DetectorModel, error) {
+	input := &iotevents.DescribeDetectorModelInput{
+		DetectorModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeDetectorModel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DetectorModel == nil || output.DetectorModel.DetectorModelConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DetectorModel, nil
+}
+
+func statusDetectorModel(ctx context.Context, conn *iotevents.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDetectorModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DetectorModelConfiguration.Status), nil
+	}
+}
+
+func waitDetectorModelActive(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*awstypes.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DetectorModelVersionStatusActivating),
+		Target:  enum.Slice(awstypes.DetectorModelVersionStatusActive),
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDetectorModelDeleted(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*awstypes.DetectorModel, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DetectorModelVersionStatusActive, awstypes.DetectorModelVersionStatusActivating, awstypes.DetectorModelVersionStatusInactive),
+		Target:  []string{},
+		Refresh: statusDetectorModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DetectorModel); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type detectorModelResourceModel struct {
+	Definition               jsontypes.Normalized                          `tfsdk:"detector_model_definition"`
+	DetectorModelARN         types.String                                  `tfsdk:"arn"`
+	DetectorModelDescription types.String                                  `tfsdk:"description"`
+	DetectorModelName        types.String                                  `tfsdk:"name"`
+	DetectorModelVersion     types.String                                  `tfsdk:"version"`
+	EvaluationMethod         fwtypes.StringEnum[awstypes.EvaluationMethod] `tfsdk:"evaluation_method"`
+	ID                       types.String                                  `tfsdk:"id"`
+	Key                      types.String                                  `tfsdk:"key"`
+	RoleARN                  fwtypes.ARN                                   `tfsdk:"role_arn"`
+	Tags                     types.Map                                     `tfsdk:"tags"`
+	TagsAll                  types.Map                                     `tfsdk:"tags_all"`
+}
+
+func (model *detectorModelResourceModel) InitFromID() error {
+	model.DetectorModelName = model.ID
+
+	return nil
+}
+
+func (model *detectorModelResourceModel) setID() {
+	model.ID = model.DetectorModelName
+}
+
+// expandJSONDocument decodes a JSON document attribute into an API object.
+func expandJSONDocument[T any](v jsontypes.Normalized) (*T, error) {
+	var apiObject T
+
+	if err := tfjson.DecodeFromString(v.ValueString(), &apiObject); err != nil {
+		return nil, err
+	}
+
+	return &apiObject, nil
+}
+
+// flattenJSONDocument encodes an API object as a JSON document attribute.
+// The existing value is preserved if it describes the same API object.
+func flattenJSONDocument[T any](old jsontypes.Normalized, apiObject *T) (jsontypes.Normalized, error) {
+	if apiObject == nil {
+		return jsontypes.NewNormalizedNull(), nil
+	}
+
+	v, err := tfjson.EncodeToBytes(apiObject)
+	if err != nil {
+		return jsontypes.NewNormalizedNull(), err
+	}
+	new := string(tfjson.RemoveEmptyFields(v))
+
+	if !old.IsNull() && !old.IsUnknown() {
+		if oldObject, err := expandJSONDocument[T](old); err == nil {
+			if v, err := tfjson.EncodeToBytes(oldObject); err == nil && tfjson.EqualStrings(string(tfjson.RemoveEmptyFields(v)), new) {
+				return old, nil
+			}
+		}
+	}
+
+	return jsontypes.NewNormalizedValue(new), nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsDetectorModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "detector_model_definition"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrKey),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"detector_model_definition"},
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceDetectorModel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsDetectorModel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDetectorModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectorModelConfig_basic(rName, "0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccDetectorModelConfig_basic(rName, "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDetectorModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_detector_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Detector Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDetectorModelExists(ctx context.Context, n string, v *awstypes.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		output, err := tfiotevents.FindDetectorModelByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfig_role(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "iotevents.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccDetectorModelConfig_basic(rName, value string) string {
+	return acctest.ConfigCompose(testAccConfig_role(rName), fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  detector_model_definition = jsonencode({
+    initialStateName = "Normal"
+    states = [{
+      stateName = "Normal"
+      onEnter = {
+        events = [{
+          eventName = "init"
+          condition = "true"
+          actions = [{
+            setVariable = {
+              variableName = "counter"
+              value        = %[2]q
+            }
+          }]
+        }]
+      }
+    }]
+  })
+}
+`, rName, value))
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+// Exports for use in tests only.
+var (
+	ResourceAlarmModel    = newAlarmModelResource
+	ResourceDetectorModel = newDetectorModelResource
+	ResourceInput         = newInputResource
+
+	FindAlarmModelByName    = findAlarmModelByName
+	FindDetectorModelByName = findDetectorModelByName
+	FindInputByName         = findInputByName
+)
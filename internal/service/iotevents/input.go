@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Input")
+// @Tags(identifierAttribute="arn")
+func newInputResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &inputResource{}, nil
+}
+
+type inputResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*inputResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotevents_input"
+}
+
+func (r *inputResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z_]*$`), "must begin with a letter and contain only alphanumeric characters and underscores"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"input_definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"attribute": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[inputAttributeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 200),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"json_path": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 128),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *inputResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data inputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	input := &iotevents.CreateInputInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateInput(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT Events Input (%s)", data.InputName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.InputARN = fwflex.StringToFramework(ctx, output.InputConfiguration.InputArn)
+	data.setID()
+
+	if _, err := waitInputActive(ctx, conn, data.ID.ValueString(), inputTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Input (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *inputResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data inputResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	output, err := findInputByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT Events Input (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.InputConfiguration, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *inputResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new inputResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	if !new.InputDefinition.Equal(old.InputDefinition) || !new.InputDescription.Equal(old.InputDescription) {
+		input := &iotevents.UpdateInputInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateInput(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT Events Input (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitInputActive(ctx, conn, new.ID.ValueString(), inputTimeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Input (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *inputResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data inputResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTEventsClient(ctx)
+
+	_, err := conn.DeleteInput(ctx, &iotevents.DeleteInputInput{
+		InputName: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT Events Input (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitInputDeleted(ctx, conn, data.ID.ValueString(), inputTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT Events Input (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *inputResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+const (
+	inputTimeout = 5 * time.Minute
+)
+
+func findInputByName(ctx context.Context, conn *iotevents.Client, name string) (*awstypes.Input, error) {
+	input := &iotevents.DescribeInputInput{
+		InputName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInput(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Input == nil || output.Input.InputConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Input, nil
+}
+
+func statusInput(ctx context.Context, conn *iotevents.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInputByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.InputConfiguration.Status), nil
+	}
+}
+
+func waitInputActive(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*awstypes.Input, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InputStatusCreating, awstypes.InputStatusUpdating),
+		Target:  enum.Slice(awstypes.InputStatusActive),
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInputDeleted(ctx context.Context, conn *iotevents.Client, name string, timeout time.Duration) (*awstypes.Input, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InputStatusDeleting),
+		Target:  []string{},
+		Refresh: statusInput(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Input); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type inputResourceModel struct {
+	ID               types.String                                          `tfsdk:"id"`
+	InputARN         types.String                                          `tfsdk:"arn"`
+	InputDefinition  fwtypes.ListNestedObjectValueOf[inputDefinitionModel] `tfsdk:"input_definition"`
+	InputDescription types.String                                          `tfsdk:"description"`
+	InputName        types.String                                          `tfsdk:"name"`
+	Tags             types.Map                                             `tfsdk:"tags"`
+	TagsAll          types.Map                                             `tfsdk:"tags_all"`
+}
+
+func (model *inputResourceModel) InitFromID() error {
+	model.InputName = model.ID
+
+	return nil
+}
+
+func (model *inputResourceModel) setID() {
+	model.ID = model.InputName
+}
+
+type inputDefinitionModel struct {
+	Attributes fwtypes.ListNestedObjectValueOf[inputAttributeModel] `tfsdk:"attribute"`
+}
+
+type inputAttributeModel struct {
+	JSONPath types.String `tfsdk:"json_path"`
+}
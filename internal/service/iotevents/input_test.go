@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotevents"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotevents/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsInput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotevents", fmt.Sprintf("input/%s", rName)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsInput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceInput, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsInput_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccInputConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.0.json_path", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "input_definition.0.attribute.1.json_path", "sensorId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsInput_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Input
+	resourceName := "aws_iotevents_input.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix("tf_acc_test"), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInputConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInputConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccInputConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_input" {
+				continue
+			}
+
+			_, err := tfiotevents.FindInputByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Input %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInputExists(ctx context.Context, n string, v *awstypes.Input) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+		output, err := tfiotevents.FindInputByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsClient(ctx)
+
+	input := &iotevents.ListInputsInput{}
+
+	_, err := conn.ListInputs(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccInputConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccInputConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+
+    attribute {
+      json_path = "sensorId"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccInputConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccInputConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
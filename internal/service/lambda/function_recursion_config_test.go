@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionRecursionConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var cfg lambda.GetFunctionRecursionConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_recursion_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionRecursionConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(types.RecursiveLoopAllow)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &cfg),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(types.RecursiveLoopAllow)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccFunctionRecursionConfigImportStateIdFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(types.RecursiveLoopTerminate)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &cfg),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(types.RecursiveLoopTerminate)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionRecursionConfig_disappears_Function(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var cfg lambda.GetFunctionRecursionConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_recursion_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionRecursionConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(types.RecursiveLoopAllow)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &cfg),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceFunction(), functionResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionRecursionConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_function_recursion_config" {
+				continue
+			}
+
+			out, err := tflambda.FindFunctionRecursionConfigByName(ctx, conn, rs.Primary.Attributes["function_name"])
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return create.Error(names.Lambda, create.ErrActionCheckingDestroyed, tflambda.ResNameFunctionRecursionConfig, rs.Primary.ID, err)
+			}
+
+			// Deleting the resource restores the default configuration.
+			if out.RecursiveLoop == types.RecursiveLoopTerminate {
+				continue
+			}
+
+			return create.Error(names.Lambda, create.ErrActionCheckingDestroyed, tflambda.ResNameFunctionRecursionConfig, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionRecursionConfigExists(ctx context.Context, name string, cfg *lambda.GetFunctionRecursionConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNameFunctionRecursionConfig, name, errors.New("not found"))
+		}
+
+		functionName := rs.Primary.Attributes["function_name"]
+		if functionName == "" {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNameFunctionRecursionConfig, name, errors.New("function_name not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		out, err := tflambda.FindFunctionRecursionConfigByName(ctx, conn, functionName)
+		if err != nil {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNameFunctionRecursionConfig, functionName, err)
+		}
+
+		*cfg = *out
+
+		return nil
+	}
+}
+
+func testAccFunctionRecursionConfigImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["function_name"], nil
+	}
+}
+
+func testAccFunctionRecursionConfigConfig_basic(rName, recursiveLoop string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs18.x"
+}
+
+resource "aws_lambda_function_recursion_config" "test" {
+  function_name  = aws_lambda_function.test.function_name
+  recursive_loop = %[2]q
+}
+`, rName, recursiveLoop))
+}
@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceFunctionRecursionConfig,
+			Name:    "Function Recursion Config",
+		},
 		{
 			Factory: newResourceRuntimeManagementConfig,
 			Name:    "Runtime Management Config",
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_launchwizard_deployment", name="Deployment")
+// @Tags(identifierAttribute="arn")
+func newDeploymentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &deploymentResource{}
+
+	r.SetDefaultCreateTimeout(180 * time.Minute)
+	r.SetDefaultDeleteTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type deploymentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[deploymentResourceModel]
+	framework.WithTimeouts
+}
+
+func (*deploymentResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_launchwizard_deployment"
+}
+
+func (r *deploymentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_pattern_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9-]+$`), "must contain only letters, numbers and hyphens (-)"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9_\s\.-]+$`), "must contain only letters, numbers, whitespace, underscores (_), periods (.) and hyphens (-)"),
+				},
+			},
+			"resource_group": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"specifications": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Map{
+					mapvalidator.SizeBetween(1, 100),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DeploymentStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9_\.-]+$`), "must contain only letters, numbers, underscores (_), periods (.) and hyphens (-)"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *deploymentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data deploymentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LaunchWizardClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &launchwizard.CreateDeploymentInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Launch Wizard Deployment (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.DeploymentId)
+
+	deployment, err := waitDeploymentCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Launch Wizard Deployment (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DeploymentARN = fwflex.StringToFramework(ctx, deployment.DeploymentArn)
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(deployment.CreatedAt)
+	data.ResourceGroup = fwflex.StringToFramework(ctx, deployment.ResourceGroup)
+	data.Status = fwtypes.StringEnumValue(deployment.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *deploymentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data deploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LaunchWizardClient(ctx)
+
+	output, err := findDeploymentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Launch Wizard Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Sensitive specification values are masked in the API response.
+	specifications := data.Specifications
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !specifications.IsNull() {
+		data.Specifications = specifications
+	}
+	data.DeploymentPatternName = fwflex.StringToFramework(ctx, output.PatternName)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *deploymentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data deploymentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LaunchWizardClient(ctx)
+
+	_, err := conn.DeleteDeployment(ctx, &launchwizard.DeleteDeploymentInput{
+		DeploymentId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Launch Wizard Deployment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDeploymentDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Launch Wizard Deployment (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *deploymentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findDeploymentByID(ctx context.Context, conn *launchwizard.Client, id string) (*awstypes.DeploymentData, error) {
+	input := &launchwizard.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Deployment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Deployment.Status; status == awstypes.DeploymentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Deployment, nil
+}
+
+func statusDeployment(ctx context.Context, conn *launchwizard.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDeploymentCreated(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStatusCreating, awstypes.DeploymentStatusInProgress, awstypes.DeploymentStatusValidating),
+		Target:     enum.Slice(awstypes.DeploymentStatusCompleted),
+		Refresh:    statusDeployment(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDeploymentDeleted(ctx context.Context, conn *launchwizard.Client, id string, timeout time.Duration) (*awstypes.DeploymentData, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStatusCompleted, awstypes.DeploymentStatusDeleteInitiating, awstypes.DeploymentStatusDeleteInProgress, awstypes.DeploymentStatusFailed),
+		Target:     []string{},
+		Refresh:    statusDeployment(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DeploymentData); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type deploymentResourceModel struct {
+	CreatedAt             timetypes.RFC3339                             `tfsdk:"created_at"`
+	DeploymentARN         types.String                                  `tfsdk:"arn"`
+	DeploymentPatternName types.String                                  `tfsdk:"deployment_pattern_name"`
+	ID                    types.String                                  `tfsdk:"id"`
+	Name                  types.String                                  `tfsdk:"name"`
+	ResourceGroup         types.String                                  `tfsdk:"resource_group"`
+	Specifications        fwtypes.MapValueOf[types.String]              `tfsdk:"specifications"`
+	Status                fwtypes.StringEnum[awstypes.DeploymentStatus] `tfsdk:"status"`
+	Tags                  types.Map                                     `tfsdk:"tags"`
+	TagsAll               types.Map                                     `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                `tfsdk:"timeouts"`
+	WorkloadName          types.String                                  `tfsdk:"workload_name"`
+}
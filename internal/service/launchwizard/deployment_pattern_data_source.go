@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Deployment Pattern")
+func newDeploymentPatternDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &deploymentPatternDataSource{}, nil
+}
+
+type deploymentPatternDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*deploymentPatternDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_launchwizard_deployment_pattern"
+}
+
+func (d *deploymentPatternDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deployment_pattern_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"specifications": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[deploymentSpecificationsFieldModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[deploymentSpecificationsFieldModel](ctx),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadDeploymentPatternStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"workload_name": schema.StringAttribute{
+				Required: true,
+			},
+			"workload_version_name": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *deploymentPatternDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deploymentPatternDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LaunchWizardClient(ctx)
+
+	workloadName, patternName := data.WorkloadName.ValueString(), data.DeploymentPatternName.ValueString()
+	output, err := findWorkloadDeploymentPatternByTwoPartKey(ctx, conn, workloadName, patternName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Launch Wizard Workload (%s) Deployment Pattern (%s)", workloadName, patternName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(workloadName + "/" + patternName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findWorkloadDeploymentPatternByTwoPartKey(ctx context.Context, conn *launchwizard.Client, workloadName, patternName string) (*awstypes.WorkloadDeploymentPatternData, error) {
+	input := &launchwizard.GetWorkloadDeploymentPatternInput{
+		DeploymentPatternName: aws.String(patternName),
+		WorkloadName:          aws.String(workloadName),
+	}
+
+	output, err := conn.GetWorkloadDeploymentPattern(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WorkloadDeploymentPattern == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WorkloadDeploymentPattern, nil
+}
+
+type deploymentPatternDataSourceModel struct {
+	DeploymentPatternName types.String                                                        `tfsdk:"deployment_pattern_name"`
+	Description           types.String                                                        `tfsdk:"description"`
+	DisplayName           types.String                                                        `tfsdk:"display_name"`
+	ID                    types.String                                                        `tfsdk:"id"`
+	Specifications        fwtypes.ListNestedObjectValueOf[deploymentSpecificationsFieldModel] `tfsdk:"specifications"`
+	Status                fwtypes.StringEnum[awstypes.WorkloadDeploymentPatternStatus]        `tfsdk:"status"`
+	StatusMessage         types.String                                                        `tfsdk:"status_message"`
+	WorkloadName          types.String                                                        `tfsdk:"workload_name"`
+	WorkloadVersionName   types.String                                                        `tfsdk:"workload_version_name"`
+}
+
+type deploymentSpecificationsFieldModel struct {
+	AllowedValues fwtypes.ListValueOf[types.String]                                `tfsdk:"allowed_values"`
+	Conditionals  fwtypes.ListNestedObjectValueOf[deploymentConditionalFieldModel] `tfsdk:"conditionals"`
+	Description   types.String                                                     `tfsdk:"description"`
+	Name          types.String                                                     `tfsdk:"name"`
+	Required      types.String                                                     `tfsdk:"required"`
+}
+
+type deploymentConditionalFieldModel struct {
+	Comparator types.String `tfsdk:"comparator"`
+	Name       types.String `tfsdk:"name"`
+	Value      types.String `tfsdk:"value"`
+}
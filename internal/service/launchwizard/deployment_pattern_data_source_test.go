@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLaunchWizardDeploymentPatternDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_launchwizard_deployment_pattern.test"
+	workloadDataSourceName := "data.aws_launchwizard_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentPatternDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_pattern_name", workloadDataSourceName, "deployment_patterns.0.deployment_pattern_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDisplayName, workloadDataSourceName, "deployment_patterns.0.display_name"),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "specifications.#", 1),
+					resource.TestCheckResourceAttrSet(dataSourceName, "specifications.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(dataSourceName, "workload_name", "SAP"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workload_version_name", workloadDataSourceName, "deployment_patterns.0.workload_version_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeploymentPatternDataSourceConfig_basic = `
+data "aws_launchwizard_workload" "test" {
+  workload_name = "SAP"
+}
+
+data "aws_launchwizard_deployment_pattern" "test" {
+  workload_name           = data.aws_launchwizard_workload.test.workload_name
+  deployment_pattern_name = data.aws_launchwizard_workload.test.deployment_patterns[0].deployment_pattern_name
+}
+`
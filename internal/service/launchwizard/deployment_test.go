@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflaunchwizard "github.com/hashicorp/terraform-provider-aws/internal/service/launchwizard"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Deployments provision real workload infrastructure, so the workload, deployment pattern
+// and a JSON-encoded map of specifications must be supplied via environment variables.
+const (
+	envVarWorkloadName          = "AWS_LAUNCHWIZARD_WORKLOAD_NAME"
+	envVarDeploymentPatternName = "AWS_LAUNCHWIZARD_DEPLOYMENT_PATTERN_NAME"
+	envVarSpecifications        = "AWS_LAUNCHWIZARD_SPECIFICATIONS"
+)
+
+func TestAccLaunchWizardDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DeploymentData
+	resourceName := "aws_launchwizard_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	workloadName := acctest.SkipIfEnvVarNotSet(t, envVarWorkloadName)
+	patternName := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentPatternName)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarSpecifications)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, workloadName, patternName, specifications),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "deployment_pattern_name", patternName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.DeploymentStatusCompleted)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", workloadName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specifications"},
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DeploymentData
+	resourceName := "aws_launchwizard_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	workloadName := acctest.SkipIfEnvVarNotSet(t, envVarWorkloadName)
+	patternName := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentPatternName)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarSpecifications)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_basic(rName, workloadName, patternName, specifications),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflaunchwizard.ResourceDeployment, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLaunchWizardDeployment_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.DeploymentData
+	resourceName := "aws_launchwizard_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	workloadName := acctest.SkipIfEnvVarNotSet(t, envVarWorkloadName)
+	patternName := acctest.SkipIfEnvVarNotSet(t, envVarDeploymentPatternName)
+	specifications := acctest.SkipIfEnvVarNotSet(t, envVarSpecifications)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LaunchWizardServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_tags1(rName, workloadName, patternName, specifications, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"specifications"},
+			},
+			{
+				Config: testAccDeploymentConfig_tags2(rName, workloadName, patternName, specifications, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_tags1(rName, workloadName, patternName, specifications, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launchwizard_deployment" {
+				continue
+			}
+
+			_, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Launch Wizard Deployment %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *awstypes.DeploymentData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+		output, err := tflaunchwizard.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LaunchWizardClient(ctx)
+
+	input := &launchwizard.ListWorkloadsInput{}
+	_, err := conn.ListWorkloads(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccDeploymentConfig_basic(rName, workloadName, patternName, specifications string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = %[2]q
+  deployment_pattern_name = %[3]q
+  specifications          = jsondecode(%[4]q)
+}
+`, rName, workloadName, patternName, specifications)
+}
+
+func testAccDeploymentConfig_tags1(rName, workloadName, patternName, specifications, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = %[2]q
+  deployment_pattern_name = %[3]q
+  specifications          = jsondecode(%[4]q)
+
+  tags = {
+    %[5]q = %[6]q
+  }
+}
+`, rName, workloadName, patternName, specifications, tagKey1, tagValue1)
+}
+
+func testAccDeploymentConfig_tags2(rName, workloadName, patternName, specifications, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_launchwizard_deployment" "test" {
+  name                    = %[1]q
+  workload_name           = %[2]q
+  deployment_pattern_name = %[3]q
+  specifications          = jsondecode(%[4]q)
+
+  tags = {
+    %[5]q = %[6]q
+    %[7]q = %[8]q
+  }
+}
+`, rName, workloadName, patternName, specifications, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package launchwizard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/launchwizard"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/launchwizard/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Workload")
+func newWorkloadDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workloadDataSource{}, nil
+}
+
+type workloadDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*workloadDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_launchwizard_workload"
+}
+
+func (d *workloadDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"deployment_patterns": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[workloadDeploymentPatternSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[workloadDeploymentPatternSummaryModel](ctx),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Computed: true,
+			},
+			"documentation_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"icon_url": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"workload_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *workloadDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workloadDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LaunchWizardClient(ctx)
+
+	name := data.WorkloadName.ValueString()
+	workload, err := findWorkloadByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Launch Wizard Workload (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, workload, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	patterns, err := findWorkloadDeploymentPatterns(ctx, conn, &launchwizard.ListWorkloadDeploymentPatternsInput{
+		WorkloadName: aws.String(name),
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Launch Wizard Workload (%s) deployment patterns", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, patterns, &data.DeploymentPatterns)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(name)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findWorkloadByName(ctx context.Context, conn *launchwizard.Client, name string) (*awstypes.WorkloadData, error) {
+	input := &launchwizard.GetWorkloadInput{
+		WorkloadName: aws.String(name),
+	}
+
+	output, err := conn.GetWorkload(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+func findWorkloadDeploymentPatterns(ctx context.Context, conn *launchwizard.Client, input *launchwizard.ListWorkloadDeploymentPatternsInput) ([]awstypes.WorkloadDeploymentPatternDataSummary, error) {
+	var output []awstypes.WorkloadDeploymentPatternDataSummary
+
+	pages := launchwizard.NewListWorkloadDeploymentPatternsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.WorkloadDeploymentPatterns...)
+	}
+
+	return output, nil
+}
+
+type workloadDataSourceModel struct {
+	DeploymentPatterns fwtypes.ListNestedObjectValueOf[workloadDeploymentPatternSummaryModel] `tfsdk:"deployment_patterns"`
+	Description        types.String                                                           `tfsdk:"description"`
+	DisplayName        types.String                                                           `tfsdk:"display_name"`
+	DocumentationURL   types.String                                                           `tfsdk:"documentation_url"`
+	IconURL            types.String                                                           `tfsdk:"icon_url"`
+	ID                 types.String                                                           `tfsdk:"id"`
+	Status             fwtypes.StringEnum[awstypes.WorkloadStatus]                            `tfsdk:"status"`
+	StatusMessage      types.String                                                           `tfsdk:"status_message"`
+	WorkloadName       types.String                                                           `tfsdk:"workload_name"`
+}
+
+type workloadDeploymentPatternSummaryModel struct {
+	DeploymentPatternName types.String                                                 `tfsdk:"deployment_pattern_name"`
+	Description           types.String                                                 `tfsdk:"description"`
+	DisplayName           types.String                                                 `tfsdk:"display_name"`
+	Status                fwtypes.StringEnum[awstypes.WorkloadDeploymentPatternStatus] `tfsdk:"status"`
+	StatusMessage         types.String                                                 `tfsdk:"status_message"`
+	WorkloadVersionName   types.String                                                 `tfsdk:"workload_version_name"`
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_anomaly_detector", name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs;cloudwatchlogs;cloudwatchlogs.GetLogAnomalyDetectorOutput")
+func resourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"anomaly_visibility_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detector_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"evaluation_frequency": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.EvaluationFrequency](),
+			},
+			"filter_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"log_group_arn_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						verify.ValidARN,
+						validation.StringDoesNotMatch(regexache.MustCompile(`:\*$`), "must not end with a wildcard suffix"),
+					),
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	input := expandCreateLogAnomalyDetectorInput(map[string]interface{}{
+		"anomaly_visibility_time": d.Get("anomaly_visibility_time"),
+		"detector_name":           d.Get("detector_name"),
+		"evaluation_frequency":    d.Get("evaluation_frequency"),
+		"filter_pattern":          d.Get("filter_pattern"),
+		names.AttrKMSKeyID:        d.Get(names.AttrKMSKeyID),
+		"log_group_arn_list":      d.Get("log_group_arn_list"),
+	})
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLogAnomalyDetector(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudWatch Logs Anomaly Detector: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.AnomalyDetectorArn))
+
+	// Newly created anomaly detectors are always enabled.
+	if !d.Get(names.AttrEnabled).(bool) {
+		input := expandUpdateLogAnomalyDetectorInput(d.Id(), map[string]interface{}{
+			"anomaly_visibility_time": d.Get("anomaly_visibility_time"),
+			names.AttrEnabled:         false,
+			"evaluation_frequency":    d.Get("evaluation_frequency"),
+			"filter_pattern":          d.Get("filter_pattern"),
+		})
+
+		_, err := conn.UpdateLogAnomalyDetector(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "disabling CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	output, err := findLogAnomalyDetectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, d.Id())
+	for k, v := range flattenLogAnomalyDetector(output) {
+		if err := d.Set(k, v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := expandUpdateLogAnomalyDetectorInput(d.Id(), map[string]interface{}{
+			"anomaly_visibility_time": d.Get("anomaly_visibility_time"),
+			names.AttrEnabled:         d.Get(names.AttrEnabled),
+			"evaluation_frequency":    d.Get("evaluation_frequency"),
+			"filter_pattern":          d.Get("filter_pattern"),
+		})
+
+		_, err := conn.UpdateLogAnomalyDetector(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAnomalyDetectorRead(ctx, d, meta)...)
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Logs Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteLogAnomalyDetector(ctx, &cloudwatchlogs.DeleteLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLogAnomalyDetectorByARN(ctx context.Context, conn *cloudwatchlogs.Client, arn string) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
+	input := &cloudwatchlogs.GetLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLogAnomalyDetector(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.AnomalyDetectorStatus; status == types.AnomalyDetectorStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func expandCreateLogAnomalyDetectorInput(tfMap map[string]interface{}) *cloudwatchlogs.CreateLogAnomalyDetectorInput {
+	apiObject := &cloudwatchlogs.CreateLogAnomalyDetectorInput{}
+
+	if v, ok := tfMap["anomaly_visibility_time"].(int); ok && v != 0 {
+		apiObject.AnomalyVisibilityTime = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["detector_name"].(string); ok && v != "" {
+		apiObject.DetectorName = aws.String(v)
+	}
+
+	if v, ok := tfMap["evaluation_frequency"].(string); ok && v != "" {
+		apiObject.EvaluationFrequency = types.EvaluationFrequency(v)
+	}
+
+	if v, ok := tfMap["filter_pattern"].(string); ok && v != "" {
+		apiObject.FilterPattern = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrKMSKeyID].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_group_arn_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LogGroupArnList = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateLogAnomalyDetectorInput(arn string, tfMap map[string]interface{}) *cloudwatchlogs.UpdateLogAnomalyDetectorInput {
+	apiObject := &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	if v, ok := tfMap["anomaly_visibility_time"].(int); ok && v != 0 {
+		apiObject.AnomalyVisibilityTime = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["evaluation_frequency"].(string); ok && v != "" {
+		apiObject.EvaluationFrequency = types.EvaluationFrequency(v)
+	}
+
+	// An empty filter pattern removes any existing pattern.
+	if v, ok := tfMap["filter_pattern"].(string); ok {
+		apiObject.FilterPattern = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLogAnomalyDetector(apiObject *cloudwatchlogs.GetLogAnomalyDetectorOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"anomaly_visibility_time": aws.ToInt64(apiObject.AnomalyVisibilityTime),
+		"detector_name":           aws.ToString(apiObject.DetectorName),
+		names.AttrEnabled:         apiObject.AnomalyDetectorStatus != types.AnomalyDetectorStatusPaused,
+		"evaluation_frequency":    string(apiObject.EvaluationFrequency),
+		"filter_pattern":          aws.ToString(apiObject.FilterPattern),
+		names.AttrKMSKeyID:        aws.ToString(apiObject.KmsKeyId),
+		"log_group_arn_list":      apiObject.LogGroupArnList,
+	}
+
+	return tfMap
+}
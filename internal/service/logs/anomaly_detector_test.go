@@ -0,0 +1,519 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandCreateLogAnomalyDetectorInput(t *testing.T) {
+	t.Parallel()
+
+	//lintignore:AWSAT003,AWSAT005
+	testCases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected *cloudwatchlogs.CreateLogAnomalyDetectorInput
+	}{
+		"empty": {
+			tfMap:    map[string]interface{}{},
+			expected: &cloudwatchlogs.CreateLogAnomalyDetectorInput{},
+		},
+		"zero values": {
+			tfMap: map[string]interface{}{
+				"anomaly_visibility_time": 0,
+				"detector_name":           "",
+				"evaluation_frequency":    "",
+				"filter_pattern":          "",
+				names.AttrKMSKeyID:        "",
+				"log_group_arn_list":      []interface{}{},
+			},
+			expected: &cloudwatchlogs.CreateLogAnomalyDetectorInput{},
+		},
+		"full": {
+			tfMap: map[string]interface{}{
+				"anomaly_visibility_time": 14,
+				"detector_name":           "test",
+				"evaluation_frequency":    "TEN_MIN",
+				"filter_pattern":          "ERROR",
+				names.AttrKMSKeyID:        "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				"log_group_arn_list": []interface{}{
+					"arn:aws:logs:us-west-2:123456789012:log-group:test1",
+					"arn:aws:logs:us-west-2:123456789012:log-group:test2",
+				},
+			},
+			expected: &cloudwatchlogs.CreateLogAnomalyDetectorInput{
+				AnomalyVisibilityTime: aws.Int64(14),
+				DetectorName:          aws.String("test"),
+				EvaluationFrequency:   types.EvaluationFrequencyTenMin,
+				FilterPattern:         aws.String("ERROR"),
+				KmsKeyId:              aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				LogGroupArnList: []string{
+					"arn:aws:logs:us-west-2:123456789012:log-group:test1",
+					"arn:aws:logs:us-west-2:123456789012:log-group:test2",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflogs.ExpandCreateLogAnomalyDetectorInput(testCase.tfMap)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(cloudwatchlogs.CreateLogAnomalyDetectorInput{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandUpdateLogAnomalyDetectorInput(t *testing.T) {
+	t.Parallel()
+
+	//lintignore:AWSAT003,AWSAT005
+	arn := "arn:aws:logs:us-west-2:123456789012:anomaly-detector:1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	testCases := map[string]struct {
+		tfMap    map[string]interface{}
+		expected *cloudwatchlogs.UpdateLogAnomalyDetectorInput
+	}{
+		"empty": {
+			tfMap: map[string]interface{}{},
+			expected: &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+				AnomalyDetectorArn: aws.String(arn),
+			},
+		},
+		"disabled": {
+			tfMap: map[string]interface{}{
+				"anomaly_visibility_time": 0,
+				names.AttrEnabled:         false,
+				"evaluation_frequency":    "",
+				"filter_pattern":          "",
+			},
+			expected: &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+				AnomalyDetectorArn: aws.String(arn),
+				Enabled:            aws.Bool(false),
+				FilterPattern:      aws.String(""),
+			},
+		},
+		"full": {
+			tfMap: map[string]interface{}{
+				"anomaly_visibility_time": 30,
+				names.AttrEnabled:         true,
+				"evaluation_frequency":    "ONE_HOUR",
+				"filter_pattern":          "WARN",
+			},
+			expected: &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+				AnomalyDetectorArn:    aws.String(arn),
+				AnomalyVisibilityTime: aws.Int64(30),
+				Enabled:               aws.Bool(true),
+				EvaluationFrequency:   types.EvaluationFrequencyOneHour,
+				FilterPattern:         aws.String("WARN"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflogs.ExpandUpdateLogAnomalyDetectorInput(arn, testCase.tfMap)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(cloudwatchlogs.UpdateLogAnomalyDetectorInput{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenLogAnomalyDetector(t *testing.T) {
+	t.Parallel()
+
+	//lintignore:AWSAT003,AWSAT005
+	testCases := map[string]struct {
+		apiObject *cloudwatchlogs.GetLogAnomalyDetectorOutput
+		expected  map[string]interface{}
+	}{
+		"nil": {
+			apiObject: nil,
+			expected:  nil,
+		},
+		"analyzing": {
+			apiObject: &cloudwatchlogs.GetLogAnomalyDetectorOutput{
+				AnomalyDetectorStatus: types.AnomalyDetectorStatusAnalyzing,
+				AnomalyVisibilityTime: aws.Int64(21),
+				DetectorName:          aws.String("test"),
+				EvaluationFrequency:   types.EvaluationFrequencyFiveMin,
+				LogGroupArnList: []string{
+					"arn:aws:logs:us-west-2:123456789012:log-group:test",
+				},
+			},
+			expected: map[string]interface{}{
+				"anomaly_visibility_time": int64(21),
+				"detector_name":           "test",
+				names.AttrEnabled:         true,
+				"evaluation_frequency":    "FIVE_MIN",
+				"filter_pattern":          "",
+				names.AttrKMSKeyID:        "",
+				"log_group_arn_list": []string{
+					"arn:aws:logs:us-west-2:123456789012:log-group:test",
+				},
+			},
+		},
+		"paused": {
+			apiObject: &cloudwatchlogs.GetLogAnomalyDetectorOutput{
+				AnomalyDetectorStatus: types.AnomalyDetectorStatusPaused,
+				AnomalyVisibilityTime: aws.Int64(7),
+				DetectorName:          aws.String("test"),
+				EvaluationFrequency:   types.EvaluationFrequencyOneMin,
+				FilterPattern:         aws.String("ERROR"),
+				KmsKeyId:              aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				LogGroupArnList: []string{
+					"arn:aws:logs:us-west-2:123456789012:log-group:test",
+				},
+			},
+			expected: map[string]interface{}{
+				"anomaly_visibility_time": int64(7),
+				"detector_name":           "test",
+				names.AttrEnabled:         false,
+				"evaluation_frequency":    "ONE_MIN",
+				"filter_pattern":          "ERROR",
+				names.AttrKMSKeyID:        "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+				"log_group_arn_list": []string{
+					"arn:aws:logs:us-west-2:123456789012:log-group:test",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflogs.FlattenLogAnomalyDetector(testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccLogsAnomalyDetector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "logs", regexache.MustCompile(`anomaly-detector:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "anomaly_visibility_time"),
+					resource.TestCheckResourceAttr(resourceName, "detector_name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "evaluation_frequency"),
+					resource.TestCheckResourceAttr(resourceName, "filter_pattern", ""),
+					resource.TestCheckResourceAttr(resourceName, "log_group_arn_list.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_arn_list.0", "aws_cloudwatch_log_group.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAnomalyDetector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsAnomalyDetector_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_full(rName, false, string(types.EvaluationFrequencyTenMin), "ERROR", 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_visibility_time", "7"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_frequency", string(types.EvaluationFrequencyTenMin)),
+					resource.TestCheckResourceAttr(resourceName, "filter_pattern", "ERROR"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKMSKeyID, "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_full(rName, true, string(types.EvaluationFrequencyOneHour), "WARN", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_visibility_time", "30"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_frequency", string(types.EvaluationFrequencyOneHour)),
+					resource.TestCheckResourceAttr(resourceName, "filter_pattern", "WARN"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsAnomalyDetector_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_anomaly_detector" {
+				continue
+			}
+
+			_, err := tflogs.FindLogAnomalyDetectorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Anomaly Detector still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalyDetectorExists(ctx context.Context, n string, v *cloudwatchlogs.GetLogAnomalyDetectorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindLogAnomalyDetectorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAnomalyDetectorConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name      = %[1]q
+  log_group_arn_list = [aws_cloudwatch_log_group.test.arn]
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_full(rName string, enabled bool, evaluationFrequency, filterPattern string, anomalyVisibilityTime int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "Enable IAM User Permissions"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+      }, {
+      Sid    = "Allow CloudWatch Logs"
+      Effect = "Allow"
+      Principal = {
+        Service = "logs.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = [
+        "kms:Encrypt*",
+        "kms:Decrypt*",
+        "kms:ReEncrypt*",
+        "kms:GenerateDataKey*",
+        "kms:Describe*",
+      ]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name           = %[1]q
+  log_group_arn_list      = [aws_cloudwatch_log_group.test.arn]
+  enabled                 = %[2]t
+  evaluation_frequency    = %[3]q
+  filter_pattern          = %[4]q
+  anomaly_visibility_time = %[5]d
+  kms_key_id              = aws_kms_key.test.arn
+}
+`, rName, enabled, evaluationFrequency, filterPattern, anomalyVisibilityTime)
+}
+
+func testAccAnomalyDetectorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name      = %[1]q
+  log_group_arn_list = [aws_cloudwatch_log_group.test.arn]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAnomalyDetectorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_anomaly_detector" "test" {
+  detector_name      = %[1]q
+  log_group_arn_list = [aws_cloudwatch_log_group.test.arn]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsDeliveryDestinationPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestinationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "delivery_destination_name", "aws_cloudwatch_log_delivery_destination.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, "delivery_destination_policy"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccDeliveryDestinationPolicyImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "delivery_destination_name",
+			},
+		},
+	})
+}
+
+func TestAccLogsDeliveryDestinationPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestinationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflogs.ResourceDeliveryDestinationPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLogsDeliveryDestinationPolicy_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_delivery_destination_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeliveryDestinationPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:CreateDelivery"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccDeliveryDestinationPolicyConfig_basic(rName, "logs:*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeliveryDestinationPolicyExists(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeliveryDestinationPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_delivery_destination_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, rs.Primary.Attributes["delivery_destination_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Delivery Destination Policy still exists: %s", rs.Primary.Attributes["delivery_destination_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDeliveryDestinationPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		_, err := tflogs.FindDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, rs.Primary.Attributes["delivery_destination_name"])
+
+		return err
+	}
+}
+
+func testAccDeliveryDestinationPolicyImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["delivery_destination_name"], nil
+	}
+}
+
+func testAccDeliveryDestinationPolicyConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_delivery_destination" "test" {
+  name = %[1]q
+
+  delivery_destination_configuration {
+    destination_resource_arn = aws_cloudwatch_log_group.test.arn
+  }
+}
+
+resource "aws_cloudwatch_log_delivery_destination_policy" "test" {
+  delivery_destination_name = aws_cloudwatch_log_delivery_destination.test.name
+
+  delivery_destination_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowLogDeliveryActions"
+      Effect = "Allow"
+      Principal = {
+        AWS = data.aws_caller_identity.current.account_id
+      }
+      Action   = %[2]q
+      Resource = aws_cloudwatch_log_delivery_destination.test.arn
+    }]
+  })
+}
+`, rName, action)
+}
@@ -5,23 +5,41 @@ package logs
 
 // Exports for use in tests only.
 var (
-	ResourceAccountPolicy        = resourceAccountPolicy
-	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
-	ResourceDestination          = resourceDestination
-	ResourceDestinationPolicy    = resourceDestinationPolicy
-	ResourceGroup                = resourceGroup
-	ResourceMetricFilter         = resourceMetricFilter
-	ResourceQueryDefinition      = resourceQueryDefinition
-	ResourceResourcePolicy       = resourceResourcePolicy
-	ResourceStream               = resourceStream
-	ResourceSubscriptionFilter   = resourceSubscriptionFilter
+	ResourceAccountPolicy             = resourceAccountPolicy
+	ResourceAnomalyDetector           = resourceAnomalyDetector
+	ResourceDataProtectionPolicy      = resourceDataProtectionPolicy
+	ResourceDelivery                  = newDeliveryResource
+	ResourceDeliveryDestination       = newDeliveryDestinationResource
+	ResourceDeliveryDestinationPolicy = newDeliveryDestinationPolicyResource
+	ResourceDeliverySource            = newDeliverySourceResource
+	ResourceDestination               = resourceDestination
+	ResourceDestinationPolicy         = resourceDestinationPolicy
+	ResourceGroup                     = resourceGroup
+	ResourceIndexPolicy               = resourceIndexPolicy
+	ResourceMetricFilter              = resourceMetricFilter
+	ResourceQueryDefinition           = resourceQueryDefinition
+	ResourceResourcePolicy            = resourceResourcePolicy
+	ResourceStream                    = resourceStream
+	ResourceSubscriptionFilter        = resourceSubscriptionFilter
 
-	FindAccountPolicyByTwoPartKey      = findAccountPolicyByTwoPartKey
-	FindDestinationByName              = findDestinationByName
-	FindLogGroupByName                 = findLogGroupByName
-	FindLogStreamByTwoPartKey          = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
-	FindMetricFilterByTwoPartKey       = findMetricFilterByTwoPartKey
-	FindQueryDefinitionByTwoPartKey    = findQueryDefinitionByTwoPartKey
-	FindResourcePolicyByName           = findResourcePolicyByName
-	FindSubscriptionFilterByTwoPartKey = findSubscriptionFilterByTwoPartKey
+	ExpandCreateLogAnomalyDetectorInput = expandCreateLogAnomalyDetectorInput
+	ExpandPutIndexPolicyInput           = expandPutIndexPolicyInput
+	ExpandUpdateLogAnomalyDetectorInput = expandUpdateLogAnomalyDetectorInput
+	FlattenIndexPolicy                  = flattenIndexPolicy
+	FlattenLogAnomalyDetector           = flattenLogAnomalyDetector
+
+	FindAccountPolicyByTwoPartKey                          = findAccountPolicyByTwoPartKey
+	FindDeliveryByID                                       = findDeliveryByID
+	FindDeliveryDestinationByName                          = findDeliveryDestinationByName
+	FindDeliveryDestinationPolicyByDeliveryDestinationName = findDeliveryDestinationPolicyByDeliveryDestinationName
+	FindDeliverySourceByName                               = findDeliverySourceByName
+	FindDestinationByName                                  = findDestinationByName
+	FindIndexPolicyByLogGroupName                          = findIndexPolicyByLogGroupName
+	FindLogAnomalyDetectorByARN                            = findLogAnomalyDetectorByARN
+	FindLogGroupByName                                     = findLogGroupByName
+	FindLogStreamByTwoPartKey                              = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
+	FindMetricFilterByTwoPartKey                           = findMetricFilterByTwoPartKey
+	FindQueryDefinitionByTwoPartKey                        = findQueryDefinitionByTwoPartKey
+	FindResourcePolicyByName                               = findResourcePolicyByName
+	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
 )
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_log_index_policy", name="Index Policy")
+func resourceIndexPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIndexPolicyPut,
+		ReadWithoutTimeout:   resourceIndexPolicyRead,
+		UpdateWithoutTimeout: resourceIndexPolicyPut,
+		DeleteWithoutTimeout: resourceIndexPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrLogGroupName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validLogGroupName,
+			},
+			"policy_document": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceIndexPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	logGroupName := d.Get(names.AttrLogGroupName).(string)
+	input, err := expandPutIndexPolicyInput(logGroupName, d.Get("policy_document").(string))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, err = conn.PutIndexPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting CloudWatch Logs Index Policy (%s): %s", logGroupName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(logGroupName)
+	}
+
+	return append(diags, resourceIndexPolicyRead(ctx, d, meta)...)
+}
+
+func resourceIndexPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	output, err := findIndexPolicyByLogGroupName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Index Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Index Policy (%s): %s", d.Id(), err)
+	}
+
+	tfMap := flattenIndexPolicy(output)
+
+	d.Set(names.AttrLogGroupName, tfMap[names.AttrLogGroupName])
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy_document").(string), tfMap["policy_document"].(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "while setting policy (%s), encountered: %s", policyToSet, err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", policyToSet, err)
+	}
+
+	d.Set("policy_document", policyToSet)
+
+	return diags
+}
+
+func resourceIndexPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Index Policy: %s", d.Id())
+	_, err := conn.DeleteIndexPolicy(ctx, &cloudwatchlogs.DeleteIndexPolicyInput{
+		LogGroupIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Logs Index Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findIndexPolicyByLogGroupName(ctx context.Context, conn *cloudwatchlogs.Client, logGroupName string) (*types.IndexPolicy, error) {
+	input := &cloudwatchlogs.DescribeIndexPoliciesInput{
+		LogGroupIdentifiers: []string{logGroupName},
+	}
+
+	return findIndexPolicy(ctx, conn, input, func(v *types.IndexPolicy) bool {
+		// Account-level policies are also returned for the log group.
+		return v.Source == types.IndexSourceLogGroup
+	})
+}
+
+func findIndexPolicy(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeIndexPoliciesInput, filter tfslices.Predicate[*types.IndexPolicy]) (*types.IndexPolicy, error) {
+	output, err := findIndexPolicies(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIndexPolicies(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeIndexPoliciesInput, filter tfslices.Predicate[*types.IndexPolicy]) ([]types.IndexPolicy, error) {
+	var output []types.IndexPolicy
+
+	// The SDK does not provide a paginator for DescribeIndexPolicies.
+	for {
+		page, err := conn.DescribeIndexPolicies(ctx, input)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.IndexPolicies {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func expandPutIndexPolicyInput(logGroupName, policyDocument string) (*cloudwatchlogs.PutIndexPolicyInput, error) {
+	policy, err := structure.NormalizeJsonString(policyDocument)
+
+	if err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", policyDocument, err)
+	}
+
+	apiObject := &cloudwatchlogs.PutIndexPolicyInput{
+		LogGroupIdentifier: aws.String(logGroupName),
+		PolicyDocument:     aws.String(policy),
+	}
+
+	return apiObject, nil
+}
+
+func flattenIndexPolicy(apiObject *types.IndexPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	// The log group is identified by its ARN.
+	logGroupName := aws.ToString(apiObject.LogGroupIdentifier)
+	if arn.IsARN(logGroupName) {
+		if v, err := arn.Parse(TrimLogGroupARNWildcardSuffix(logGroupName)); err == nil {
+			logGroupName = strings.TrimPrefix(v.Resource, "log-group:")
+		}
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrLogGroupName: logGroupName,
+		"policy_document":      aws.ToString(apiObject.PolicyDocument),
+	}
+
+	return tfMap
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandPutIndexPolicyInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		logGroupName   string
+		policyDocument string
+		expected       *cloudwatchlogs.PutIndexPolicyInput
+		expectedErr    bool
+	}{
+		"normalized": {
+			logGroupName: "test",
+			policyDocument: `{
+  "Fields": ["eventName", "requestId"]
+}`,
+			expected: &cloudwatchlogs.PutIndexPolicyInput{
+				LogGroupIdentifier: aws.String("test"),
+				PolicyDocument:     aws.String(`{"Fields":["eventName","requestId"]}`),
+			},
+		},
+		"invalid JSON": {
+			logGroupName:   "test",
+			policyDocument: `{"Fields":`,
+			expectedErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tflogs.ExpandPutIndexPolicyInput(testCase.logGroupName, testCase.policyDocument)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("ExpandPutIndexPolicyInput() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(cloudwatchlogs.PutIndexPolicyInput{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenIndexPolicy(t *testing.T) {
+	t.Parallel()
+
+	//lintignore:AWSAT003,AWSAT005
+	testCases := map[string]struct {
+		apiObject *types.IndexPolicy
+		expected  map[string]interface{}
+	}{
+		"nil": {
+			apiObject: nil,
+			expected:  nil,
+		},
+		"name": {
+			apiObject: &types.IndexPolicy{
+				LogGroupIdentifier: aws.String("test"),
+				PolicyDocument:     aws.String(`{"Fields":["eventName"]}`),
+			},
+			expected: map[string]interface{}{
+				names.AttrLogGroupName: "test",
+				"policy_document":      `{"Fields":["eventName"]}`,
+			},
+		},
+		"ARN": {
+			apiObject: &types.IndexPolicy{
+				LogGroupIdentifier: aws.String("arn:aws:logs:us-west-2:123456789012:log-group:/aws/test"),
+				PolicyDocument:     aws.String(`{"Fields":["eventName"]}`),
+			},
+			expected: map[string]interface{}{
+				names.AttrLogGroupName: "/aws/test",
+				"policy_document":      `{"Fields":["eventName"]}`,
+			},
+		},
+		"ARN with wildcard suffix": {
+			apiObject: &types.IndexPolicy{
+				LogGroupIdentifier: aws.String("arn:aws:logs:us-west-2:123456789012:log-group:test:*"),
+				PolicyDocument:     aws.String(`{"Fields":["eventName"]}`),
+			},
+			expected: map[string]interface{}{
+				names.AttrLogGroupName: "test",
+				"policy_document":      `{"Fields":["eventName"]}`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tflogs.FlattenIndexPolicy(testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccLogsIndexPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.IndexPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_index_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexPolicyConfig_basic(rName, `["eventName"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrLogGroupName, "aws_cloudwatch_log_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", `{"Fields":["eventName"]}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIndexPolicyConfig_basic(rName, `["eventName", "requestId"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIndexPolicyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "policy_document", `{"Fields":["eventName","requestId"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsIndexPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.IndexPolicy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_index_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIndexPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIndexPolicyConfig_basic(rName, `["eventName"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIndexPolicyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceIndexPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIndexPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_log_index_policy" {
+				continue
+			}
+
+			_, err := tflogs.FindIndexPolicyByLogGroupName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Logs Index Policy still exists: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIndexPolicyExists(ctx context.Context, n string, v *types.IndexPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+		output, err := tflogs.FindIndexPolicyByLogGroupName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIndexPolicyConfig_basic(rName, fields string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_index_policy" "test" {
+  log_group_name = aws_cloudwatch_log_group.test.name
+
+  policy_document = jsonencode({
+    Fields = %[2]s
+  })
+}
+`, rName, fields)
+}
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflookoutmetrics "github.com/hashicorp/terraform-provider-aws/internal/service/lookoutmetrics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLookoutMetricsAlert_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	resourceName := "aws_lookoutmetrics_alert.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_sns(rName, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "action.0.lambda_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "action.0.sns_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.sns_configuration.0.role_arn", "aws_iam_role.alert", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "action.0.sns_configuration.0.sns_format"),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.sns_configuration.0.sns_topic_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "50"),
+					resource.TestCheckResourceAttrPair(resourceName, "anomaly_detector_arn", "aws_lookoutmetrics_anomaly_detector.test", names.AttrARN),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "lookoutmetrics", regexache.MustCompile(`Alert:.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAlert_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	resourceName := "aws_lookoutmetrics_alert.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_sns(rName, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflookoutmetrics.ResourceAlert, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAlert_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	resourceName := "aws_lookoutmetrics_alert.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_sns(rName, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "50"),
+				),
+			},
+			{
+				Config: testAccAlertConfig_sns(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alert_sensitivity_threshold", "80"),
+				),
+			},
+			{
+				Config: testAccAlertConfig_lambda(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Lambda alert"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.lambda_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.lambda_configuration.0.lambda_arn", "aws_lambda_function.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "action.0.sns_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.metric_list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.dimension_filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alert_filters.0.dimension_filter.0.dimension_name", "InstanceId"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAlert_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Alert
+	resourceName := "aws_lookoutmetrics_alert.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlertDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAlertConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAlertConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlertExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAlertDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lookoutmetrics_alert" {
+				continue
+			}
+
+			_, err := tflookoutmetrics.FindAlertByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lookout for Metrics Alert %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAlertExists(ctx context.Context, n string, v *awstypes.Alert) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		output, err := tflookoutmetrics.FindAlertByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAlertConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_role" "alert" {
+  name = "%[1]s-alert"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lookoutmetrics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccAlertConfig_baseSNS(rName string) string {
+	return acctest.ConfigCompose(testAccAlertConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role_policy" "alert" {
+  name = %[1]q
+  role = aws_iam_role.alert.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "sns:Publish"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccAlertConfig_sns(rName string, threshold int) string {
+	return acctest.ConfigCompose(testAccAlertConfig_baseSNS(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_alert" "test" {
+  name                        = %[1]q
+  alert_sensitivity_threshold = %[2]d
+  anomaly_detector_arn        = aws_lookoutmetrics_anomaly_detector.test.arn
+
+  action {
+    sns_configuration {
+      role_arn      = aws_iam_role.alert.arn
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.alert]
+}
+`, rName, threshold))
+}
+
+func testAccAlertConfig_lambda(rName string) string {
+	return acctest.ConfigCompose(testAccAlertConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_iam_role_policy" "alert" {
+  name = %[1]q
+  role = aws_iam_role.alert.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "lambda:InvokeFunction"
+      Resource = aws_lambda_function.test.arn
+    }]
+  })
+}
+
+resource "aws_lookoutmetrics_alert" "test" {
+  name                 = %[1]q
+  description          = "Lambda alert"
+  anomaly_detector_arn = aws_lookoutmetrics_anomaly_detector.test.arn
+
+  action {
+    lambda_configuration {
+      lambda_arn = aws_lambda_function.test.arn
+      role_arn   = aws_iam_role.alert.arn
+    }
+  }
+
+  alert_filters {
+    metric_list = ["CPUUtilization"]
+
+    dimension_filter {
+      dimension_name       = "InstanceId"
+      dimension_value_list = ["i-1234567890abcdef0"]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.alert]
+}
+`, rName))
+}
+
+func testAccAlertConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAlertConfig_baseSNS(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_alert" "test" {
+  name                        = %[1]q
+  alert_sensitivity_threshold = 50
+  anomaly_detector_arn        = aws_lookoutmetrics_anomaly_detector.test.arn
+
+  action {
+    sns_configuration {
+      role_arn      = aws_iam_role.alert.arn
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.alert]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAlertConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAlertConfig_baseSNS(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_alert" "test" {
+  name                        = %[1]q
+  alert_sensitivity_threshold = 50
+  anomaly_detector_arn        = aws_lookoutmetrics_anomaly_detector.test.arn
+
+  action {
+    sns_configuration {
+      role_arn      = aws_iam_role.alert.arn
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.alert]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
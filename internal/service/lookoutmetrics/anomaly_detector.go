@@ -0,0 +1,1081 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lookoutmetrics_anomaly_detector", name="Anomaly Detector")
+// @Tags(identifierAttribute="arn")
+func newAnomalyDetectorResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &anomalyDetectorResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type anomalyDetectorResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*anomalyDetectorResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lookoutmetrics_anomaly_detector"
+}
+
+func (r *anomalyDetectorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	roleARNAttribute := schema.StringAttribute{
+		CustomType: fwtypes.ARNType,
+		Required:   true,
+	}
+	vpcConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"security_group_id_list": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				"subnet_id_list": schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+	databaseAttributes := func(identifierAttributeName string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			identifierAttributeName: schema.StringAttribute{
+				Required: true,
+			},
+			"database_host": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDatabaseName: schema.StringAttribute{
+				Required: true,
+			},
+			"database_port": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			names.AttrRoleARN: roleARNAttribute,
+			"secret_manager_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+			},
+		}
+	}
+	optionalComputedStringAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"activate": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]*$`), "must start with a letter or number and contain only letters, numbers, underscores (_) and hyphens (-)"),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnomalyDetectorStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"anomaly_detector_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[anomalyDetectorConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"anomaly_detector_frequency": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Frequency](),
+							Required:   true,
+						},
+					},
+				},
+			},
+			"metric_set": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metricSetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
+						},
+						"dimension_list": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"frequency": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Frequency](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 63),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_-]*$`), "must start with a letter or number and contain only letters, numbers, underscores (_) and hyphens (-)"),
+							},
+						},
+						"offset": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(0, 432000),
+							},
+						},
+						"timezone": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"dimension_filter": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[metricSetDimensionFilterModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrFilter: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[filterModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"dimension_value": schema.StringAttribute{
+													Required: true,
+												},
+												"filter_operation": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FilterOperation](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"aggregation_function": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AggregationFunction](),
+										Required:   true,
+									},
+									"metric_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrNamespace: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"metric_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[metricSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"cloudwatch_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cloudWatchConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("cloudwatch_config"),
+												path.MatchRelative().AtParent().AtName("rds_source_config"),
+												path.MatchRelative().AtParent().AtName("redshift_source_config"),
+												path.MatchRelative().AtParent().AtName("s3_source_config"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrRoleARN: roleARNAttribute,
+											},
+											Blocks: map[string]schema.Block{
+												"back_test_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[backTestConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"run_back_test_mode": schema.BoolAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"rds_source_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rdsSourceConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: databaseAttributes("db_instance_identifier"),
+											Blocks: map[string]schema.Block{
+												names.AttrVPCConfiguration: vpcConfigurationBlock,
+											},
+										},
+									},
+									"redshift_source_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftSourceConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: databaseAttributes(names.AttrClusterIdentifier),
+											Blocks: map[string]schema.Block{
+												names.AttrVPCConfiguration: vpcConfigurationBlock,
+											},
+										},
+									},
+									"s3_source_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3SourceConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"historical_data_path_list": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												names.AttrRoleARN: roleARNAttribute,
+												"templated_path_list": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"file_format_descriptor": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[fileFormatDescriptorModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"csv_format_descriptor": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[csvFormatDescriptorModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																	listvalidator.ExactlyOneOf(
+																		path.MatchRelative().AtParent().AtName("csv_format_descriptor"),
+																		path.MatchRelative().AtParent().AtName("json_format_descriptor"),
+																	),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"charset": optionalComputedStringAttribute(),
+																		"contains_header": schema.BoolAttribute{
+																			Optional: true,
+																			Computed: true,
+																			PlanModifiers: []planmodifier.Bool{
+																				boolplanmodifier.UseStateForUnknown(),
+																			},
+																		},
+																		"delimiter": optionalComputedStringAttribute(),
+																		"file_compression": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.CSVFileCompression](),
+																			Optional:   true,
+																			Computed:   true,
+																			PlanModifiers: []planmodifier.String{
+																				stringplanmodifier.UseStateForUnknown(),
+																			},
+																		},
+																		"header_list": schema.ListAttribute{
+																			CustomType:  fwtypes.ListOfStringType,
+																			ElementType: types.StringType,
+																			Optional:    true,
+																		},
+																		"quote_symbol": optionalComputedStringAttribute(),
+																	},
+																},
+															},
+															"json_format_descriptor": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[jsonFormatDescriptorModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"charset": optionalComputedStringAttribute(),
+																		"file_compression": schema.StringAttribute{
+																			CustomType: fwtypes.StringEnumType[awstypes.JsonFileCompression](),
+																			Optional:   true,
+																			Computed:   true,
+																			PlanModifiers: []planmodifier.String{
+																				stringplanmodifier.UseStateForUnknown(),
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timestamp_column": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[timestampColumnModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"column_format": schema.StringAttribute{
+										Optional: true,
+									},
+									"column_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *anomalyDetectorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	name := data.AnomalyDetectorName.ValueString()
+	input := &lookoutmetrics.CreateAnomalyDetectorInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAnomalyDetector(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lookout for Metrics Anomaly Detector (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	arn := aws.ToString(output.AnomalyDetectorArn)
+	data.AnomalyDetectorARN = fwflex.StringValueToFramework(ctx, arn)
+	data.ID = data.AnomalyDetectorARN
+
+	metricSet, diags := data.MetricSet.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	inputCMS := &lookoutmetrics.CreateMetricSetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, metricSet, inputCMS)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	inputCMS.AnomalyDetectorArn = aws.String(arn)
+	inputCMS.Tags = getTagsIn(ctx)
+
+	_, err = conn.CreateMetricSet(ctx, inputCMS)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lookout for Metrics Anomaly Detector (%s) metric set (%s)", arn, metricSet.MetricSetName.ValueString()), err.Error())
+
+		return
+	}
+
+	if data.Activate.ValueBool() {
+		if err := activateAnomalyDetector(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("activating Lookout for Metrics Anomaly Detector (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(refreshAnomalyDetector(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *anomalyDetectorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	arn := data.ID.ValueString()
+	output, err := findAnomalyDetectorByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s)", arn), err.Error())
+
+		return
+	}
+
+	metricSet, err := findMetricSetByAnomalyDetectorARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s) metric set", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output, metricSet)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *anomalyDetectorResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	arn := new.ID.ValueString()
+	timeout := r.UpdateTimeout(ctx, new.Timeouts)
+
+	// Deactivate the detector before any other changes are made.
+	if old.Activate.ValueBool() && !new.Activate.ValueBool() {
+		if err := deactivateAnomalyDetector(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deactivating Lookout for Metrics Anomaly Detector (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.AnomalyDetectorConfig.Equal(old.AnomalyDetectorConfig) ||
+		!new.AnomalyDetectorDescription.Equal(old.AnomalyDetectorDescription) ||
+		!new.KMSKeyARN.Equal(old.KMSKeyARN) {
+		input := &lookoutmetrics.UpdateAnomalyDetectorInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateAnomalyDetector(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lookout for Metrics Anomaly Detector (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.MetricSet.Equal(old.MetricSet) {
+		metricSet, diags := new.MetricSet.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &lookoutmetrics.UpdateMetricSetInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, metricSet, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateMetricSet(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Lookout for Metrics Anomaly Detector (%s) metric set (%s)", arn, metricSet.MetricSetARN.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	// Activate the detector once all other changes have been made.
+	if !old.Activate.ValueBool() && new.Activate.ValueBool() {
+		if err := activateAnomalyDetector(ctx, conn, arn, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("activating Lookout for Metrics Anomaly Detector (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(refreshAnomalyDetector(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *anomalyDetectorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data anomalyDetectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LookoutMetricsClient(ctx)
+
+	_, err := conn.DeleteAnomalyDetector(ctx, &lookoutmetrics.DeleteAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lookout for Metrics Anomaly Detector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAnomalyDetectorDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lookout for Metrics Anomaly Detector (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *anomalyDetectorResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// refreshAnomalyDetector reads the detector and its metric set after they have been created or updated.
+func refreshAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, data *anomalyDetectorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	arn := data.ID.ValueString()
+	output, err := findAnomalyDetectorByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s)", arn), err.Error())
+
+		return diags
+	}
+
+	metricSet, err := findMetricSetByAnomalyDetectorARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Lookout for Metrics Anomaly Detector (%s) metric set", arn), err.Error())
+
+		return diags
+	}
+
+	diags.Append(data.flatten(ctx, output, metricSet)...)
+
+	return diags
+}
+
+func anomalyDetectorIsActive(status awstypes.AnomalyDetectorStatus) bool {
+	switch status {
+	case awstypes.AnomalyDetectorStatusActivating,
+		awstypes.AnomalyDetectorStatusActive,
+		awstypes.AnomalyDetectorStatusBackTestActivating,
+		awstypes.AnomalyDetectorStatusBackTestActive,
+		awstypes.AnomalyDetectorStatusBackTestComplete,
+		awstypes.AnomalyDetectorStatusLearning:
+		return true
+	default:
+		return false
+	}
+}
+
+func activateAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) error {
+	_, err := conn.ActivateAnomalyDetector(ctx, &lookoutmetrics.ActivateAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitAnomalyDetectorActivated(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for activation: %w", err)
+	}
+
+	return nil
+}
+
+func deactivateAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) error {
+	_, err := conn.DeactivateAnomalyDetector(ctx, &lookoutmetrics.DeactivateAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitAnomalyDetectorDeactivated(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for deactivation: %w", err)
+	}
+
+	return nil
+}
+
+func findAnomalyDetectorByARN(ctx context.Context, conn *lookoutmetrics.Client, arn string) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	input := &lookoutmetrics.DescribeAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAnomalyDetector(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findMetricSetByAnomalyDetectorARN(ctx context.Context, conn *lookoutmetrics.Client, arn string) (*lookoutmetrics.DescribeMetricSetOutput, error) {
+	input := &lookoutmetrics.ListMetricSetsInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	var output []awstypes.MetricSetSummary
+
+	pages := lookoutmetrics.NewListMetricSetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.MetricSetSummaryList...)
+	}
+
+	summary, err := tfresource.AssertSingleValueResult(output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findMetricSetByARN(ctx, conn, aws.ToString(summary.MetricSetArn))
+}
+
+func findMetricSetByARN(ctx context.Context, conn *lookoutmetrics.Client, arn string) (*lookoutmetrics.DescribeMetricSetOutput, error) {
+	input := &lookoutmetrics.DescribeMetricSetInput{
+		MetricSetArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeMetricSet(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAnomalyDetector(ctx context.Context, conn *lookoutmetrics.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAnomalyDetectorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAnomalyDetectorActivated(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AnomalyDetectorStatusActivating, awstypes.AnomalyDetectorStatusBackTestActivating, awstypes.AnomalyDetectorStatusInactive, awstypes.AnomalyDetectorStatusDeactivated),
+		Target:  enum.Slice(awstypes.AnomalyDetectorStatusActive, awstypes.AnomalyDetectorStatusBackTestActive, awstypes.AnomalyDetectorStatusBackTestComplete, awstypes.AnomalyDetectorStatusLearning),
+		Refresh: statusAnomalyDetector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lookoutmetrics.DescribeAnomalyDetectorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAnomalyDetectorDeactivated(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AnomalyDetectorStatusActive, awstypes.AnomalyDetectorStatusBackTestActive, awstypes.AnomalyDetectorStatusBackTestComplete, awstypes.AnomalyDetectorStatusDeactivating, awstypes.AnomalyDetectorStatusLearning),
+		Target:  enum.Slice(awstypes.AnomalyDetectorStatusDeactivated, awstypes.AnomalyDetectorStatusInactive),
+		Refresh: statusAnomalyDetector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lookoutmetrics.DescribeAnomalyDetectorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAnomalyDetectorDeleted(ctx context.Context, conn *lookoutmetrics.Client, arn string, timeout time.Duration) (*lookoutmetrics.DescribeAnomalyDetectorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AnomalyDetectorStatusDeleting),
+		Target:  []string{},
+		Refresh: statusAnomalyDetector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lookoutmetrics.DescribeAnomalyDetectorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type anomalyDetectorResourceModel struct {
+	Activate                   types.Bool                                                  `tfsdk:"activate"`
+	AnomalyDetectorARN         types.String                                                `tfsdk:"arn"`
+	AnomalyDetectorConfig      fwtypes.ListNestedObjectValueOf[anomalyDetectorConfigModel] `tfsdk:"anomaly_detector_config"`
+	AnomalyDetectorDescription types.String                                                `tfsdk:"description"`
+	AnomalyDetectorName        types.String                                                `tfsdk:"name"`
+	ID                         types.String                                                `tfsdk:"id"`
+	KMSKeyARN                  fwtypes.ARN                                                 `tfsdk:"kms_key_arn"`
+	MetricSet                  fwtypes.ListNestedObjectValueOf[metricSetModel]             `tfsdk:"metric_set"`
+	Status                     fwtypes.StringEnum[awstypes.AnomalyDetectorStatus]          `tfsdk:"status"`
+	Tags                       types.Map                                                   `tfsdk:"tags"`
+	TagsAll                    types.Map                                                   `tfsdk:"tags_all"`
+	Timeouts                   timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+func (data *anomalyDetectorResourceModel) flatten(ctx context.Context, detector *lookoutmetrics.DescribeAnomalyDetectorOutput, metricSet *lookoutmetrics.DescribeMetricSetOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, detector, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Activate = types.BoolValue(anomalyDetectorIsActive(detector.Status))
+
+	var metricSetData metricSetModel
+	diags.Append(fwflex.Flatten(ctx, metricSet, &metricSetData)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.MetricSet = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &metricSetData)
+
+	return diags
+}
+
+type anomalyDetectorConfigModel struct {
+	AnomalyDetectorFrequency fwtypes.StringEnum[awstypes.Frequency] `tfsdk:"anomaly_detector_frequency"`
+}
+
+type metricSetModel struct {
+	DimensionFilterList  fwtypes.ListNestedObjectValueOf[metricSetDimensionFilterModel] `tfsdk:"dimension_filter"`
+	DimensionList        fwtypes.ListValueOf[types.String]                              `tfsdk:"dimension_list"`
+	MetricList           fwtypes.ListNestedObjectValueOf[metricModel]                   `tfsdk:"metric"`
+	MetricSetARN         types.String                                                   `tfsdk:"arn"`
+	MetricSetDescription types.String                                                   `tfsdk:"description"`
+	MetricSetFrequency   fwtypes.StringEnum[awstypes.Frequency]                         `tfsdk:"frequency"`
+	MetricSetName        types.String                                                   `tfsdk:"name"`
+	MetricSource         fwtypes.ListNestedObjectValueOf[metricSourceModel]             `tfsdk:"metric_source"`
+	Offset               types.Int64                                                    `tfsdk:"offset"`
+	TimestampColumn      fwtypes.ListNestedObjectValueOf[timestampColumnModel]          `tfsdk:"timestamp_column"`
+	Timezone             types.String                                                   `tfsdk:"timezone"`
+}
+
+type metricSetDimensionFilterModel struct {
+	FilterList fwtypes.ListNestedObjectValueOf[filterModel] `tfsdk:"filter"`
+	Name       types.String                                 `tfsdk:"name"`
+}
+
+type filterModel struct {
+	DimensionValue  types.String                                 `tfsdk:"dimension_value"`
+	FilterOperation fwtypes.StringEnum[awstypes.FilterOperation] `tfsdk:"filter_operation"`
+}
+
+type metricModel struct {
+	AggregationFunction fwtypes.StringEnum[awstypes.AggregationFunction] `tfsdk:"aggregation_function"`
+	MetricName          types.String                                     `tfsdk:"metric_name"`
+	Namespace           types.String                                     `tfsdk:"namespace"`
+}
+
+type metricSourceModel struct {
+	CloudWatchConfig     fwtypes.ListNestedObjectValueOf[cloudWatchConfigModel]     `tfsdk:"cloudwatch_config"`
+	RDSSourceConfig      fwtypes.ListNestedObjectValueOf[rdsSourceConfigModel]      `tfsdk:"rds_source_config"`
+	RedshiftSourceConfig fwtypes.ListNestedObjectValueOf[redshiftSourceConfigModel] `tfsdk:"redshift_source_config"`
+	S3SourceConfig       fwtypes.ListNestedObjectValueOf[s3SourceConfigModel]       `tfsdk:"s3_source_config"`
+}
+
+type cloudWatchConfigModel struct {
+	BackTestConfiguration fwtypes.ListNestedObjectValueOf[backTestConfigurationModel] `tfsdk:"back_test_configuration"`
+	RoleARN               fwtypes.ARN                                                 `tfsdk:"role_arn"`
+}
+
+type backTestConfigurationModel struct {
+	RunBackTestMode types.Bool `tfsdk:"run_back_test_mode"`
+}
+
+type rdsSourceConfigModel struct {
+	DBInstanceIdentifier types.String                                           `tfsdk:"db_instance_identifier"`
+	DatabaseHost         types.String                                           `tfsdk:"database_host"`
+	DatabaseName         types.String                                           `tfsdk:"database_name"`
+	DatabasePort         types.Int64                                            `tfsdk:"database_port"`
+	RoleARN              fwtypes.ARN                                            `tfsdk:"role_arn"`
+	SecretManagerARN     fwtypes.ARN                                            `tfsdk:"secret_manager_arn"`
+	TableName            types.String                                           `tfsdk:"table_name"`
+	VPCConfiguration     fwtypes.ListNestedObjectValueOf[vpcConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+type redshiftSourceConfigModel struct {
+	ClusterIdentifier types.String                                           `tfsdk:"cluster_identifier"`
+	DatabaseHost      types.String                                           `tfsdk:"database_host"`
+	DatabaseName      types.String                                           `tfsdk:"database_name"`
+	DatabasePort      types.Int64                                            `tfsdk:"database_port"`
+	RoleARN           fwtypes.ARN                                            `tfsdk:"role_arn"`
+	SecretManagerARN  fwtypes.ARN                                            `tfsdk:"secret_manager_arn"`
+	TableName         types.String                                           `tfsdk:"table_name"`
+	VPCConfiguration  fwtypes.ListNestedObjectValueOf[vpcConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+type vpcConfigurationModel struct {
+	SecurityGroupIDList fwtypes.SetValueOf[types.String] `tfsdk:"security_group_id_list"`
+	SubnetIDList        fwtypes.SetValueOf[types.String] `tfsdk:"subnet_id_list"`
+}
+
+type s3SourceConfigModel struct {
+	FileFormatDescriptor   fwtypes.ListNestedObjectValueOf[fileFormatDescriptorModel] `tfsdk:"file_format_descriptor"`
+	HistoricalDataPathList fwtypes.ListValueOf[types.String]                          `tfsdk:"historical_data_path_list"`
+	RoleARN                fwtypes.ARN                                                `tfsdk:"role_arn"`
+	TemplatedPathList      fwtypes.ListValueOf[types.String]                          `tfsdk:"templated_path_list"`
+}
+
+type fileFormatDescriptorModel struct {
+	CSVFormatDescriptor  fwtypes.ListNestedObjectValueOf[csvFormatDescriptorModel]  `tfsdk:"csv_format_descriptor"`
+	JSONFormatDescriptor fwtypes.ListNestedObjectValueOf[jsonFormatDescriptorModel] `tfsdk:"json_format_descriptor"`
+}
+
+type csvFormatDescriptorModel struct {
+	Charset         types.String                                    `tfsdk:"charset"`
+	ContainsHeader  types.Bool                                      `tfsdk:"contains_header"`
+	Delimiter       types.String                                    `tfsdk:"delimiter"`
+	FileCompression fwtypes.StringEnum[awstypes.CSVFileCompression] `tfsdk:"file_compression"`
+	HeaderList      fwtypes.ListValueOf[types.String]               `tfsdk:"header_list"`
+	QuoteSymbol     types.String                                    `tfsdk:"quote_symbol"`
+}
+
+type jsonFormatDescriptorModel struct {
+	Charset         types.String                                     `tfsdk:"charset"`
+	FileCompression fwtypes.StringEnum[awstypes.JsonFileCompression] `tfsdk:"file_compression"`
+}
+
+type timestampColumnModel struct {
+	ColumnFormat types.String `tfsdk:"column_format"`
+	ColumnName   types.String `tfsdk:"column_name"`
+}
@@ -0,0 +1,593 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/lookoutmetrics"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lookoutmetrics/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflookoutmetrics "github.com/hashicorp/terraform-provider-aws/internal/service/lookoutmetrics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLookoutMetricsAnomalyDetector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeAnomalyDetectorOutput
+	resourceName := "aws_lookoutmetrics_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activate", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_detector_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "anomaly_detector_config.0.anomaly_detector_frequency", "PT1H"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "lookoutmetrics", regexache.MustCompile(`AnomalyDetector:.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "metric_set.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.dimension_list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.frequency", "PT1H"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric.0.aggregation_function", "AVG"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric.0.namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric_source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric_source.0.cloudwatch_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "metric_set.0.metric_source.0.cloudwatch_config.0.role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AnomalyDetectorStatusInactive)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAnomalyDetector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeAnomalyDetectorOutput
+	resourceName := "aws_lookoutmetrics_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflookoutmetrics.ResourceAnomalyDetector, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAnomalyDetector_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeAnomalyDetectorOutput
+	resourceName := "aws_lookoutmetrics_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.dimension_filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.dimension_filter.0.name", "InstanceType"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.dimension_filter.0.filter.0.dimension_value", "t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.dimension_filter.0.filter.0.filter_operation", "EQUALS"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.offset", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAnomalyDetector_activate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeAnomalyDetectorOutput
+	resourceName := "aws_lookoutmetrics_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_activate(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activate", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_activate(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activate", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AnomalyDetectorStatusDeactivated)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAnomalyDetector_s3Source(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeAnomalyDetectorOutput
+	resourceName := "aws_lookoutmetrics_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_s3Source(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric_source.0.s3_source_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric_source.0.s3_source_config.0.file_format_descriptor.0.csv_format_descriptor.0.contains_header", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric_source.0.s3_source_config.0.file_format_descriptor.0.csv_format_descriptor.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.metric_source.0.s3_source_config.0.templated_path_list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.timestamp_column.0.column_format", "yyyy-MM-dd HH:mm:ss"),
+					resource.TestCheckResourceAttr(resourceName, "metric_set.0.timestamp_column.0.column_name", "timestamp"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccLookoutMetricsAnomalyDetector_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lookoutmetrics.DescribeAnomalyDetectorOutput
+	resourceName := "aws_lookoutmetrics_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LookoutMetricsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalyDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lookoutmetrics_anomaly_detector" {
+				continue
+			}
+
+			_, err := tflookoutmetrics.FindAnomalyDetectorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lookout for Metrics Anomaly Detector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAnomalyDetectorExists(ctx context.Context, n string, v *lookoutmetrics.DescribeAnomalyDetectorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+		output, err := tflookoutmetrics.FindAnomalyDetectorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LookoutMetricsClient(ctx)
+
+	input := &lookoutmetrics.ListAnomalyDetectorsInput{}
+	_, err := conn.ListAnomalyDetectors(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccAnomalyDetectorConfig_baseCloudWatch(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lookoutmetrics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/CloudWatchReadOnlyAccess"
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_baseCloudWatch(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name = %[1]q
+
+  anomaly_detector_config {
+    anomaly_detector_frequency = "PT1H"
+  }
+
+  metric_set {
+    name           = %[1]q
+    dimension_list = ["InstanceId"]
+
+    metric {
+      aggregation_function = "AVG"
+      metric_name          = "CPUUtilization"
+      namespace            = "AWS/EC2"
+    }
+
+    metric_source {
+      cloudwatch_config {
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAnomalyDetectorConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_baseCloudWatch(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  anomaly_detector_config {
+    anomaly_detector_frequency = "PT1H"
+  }
+
+  metric_set {
+    name           = %[1]q
+    description    = "updated"
+    dimension_list = ["InstanceId", "InstanceType"]
+    offset         = 300
+
+    dimension_filter {
+      name = "InstanceType"
+
+      filter {
+        dimension_value  = "t3.micro"
+        filter_operation = "EQUALS"
+      }
+    }
+
+    metric {
+      aggregation_function = "AVG"
+      metric_name          = "CPUUtilization"
+      namespace            = "AWS/EC2"
+    }
+
+    metric {
+      aggregation_function = "SUM"
+      metric_name          = "NetworkIn"
+      namespace            = "AWS/EC2"
+    }
+
+    metric_source {
+      cloudwatch_config {
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAnomalyDetectorConfig_activate(rName string, activate bool) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_baseCloudWatch(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name     = %[1]q
+  activate = %[2]t
+
+  anomaly_detector_config {
+    anomaly_detector_frequency = "PT1H"
+  }
+
+  metric_set {
+    name           = %[1]q
+    dimension_list = ["InstanceId"]
+
+    metric {
+      aggregation_function = "AVG"
+      metric_name          = "CPUUtilization"
+      namespace            = "AWS/EC2"
+    }
+
+    metric_source {
+      cloudwatch_config {
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, activate))
+}
+
+func testAccAnomalyDetectorConfig_s3Source(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "lookoutmetrics.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name = %[1]q
+
+  anomaly_detector_config {
+    anomaly_detector_frequency = "PT1H"
+  }
+
+  metric_set {
+    name           = %[1]q
+    dimension_list = ["region"]
+
+    metric {
+      aggregation_function = "SUM"
+      metric_name          = "revenue"
+    }
+
+    metric_source {
+      s3_source_config {
+        role_arn            = aws_iam_role.test.arn
+        templated_path_list = ["s3://${aws_s3_bucket.test.bucket}/data/{{yyyyMMdd}}/{{HHmm}}"]
+
+        file_format_descriptor {
+          csv_format_descriptor {
+            contains_header = true
+            delimiter       = ","
+          }
+        }
+      }
+    }
+
+    timestamp_column {
+      column_format = "yyyy-MM-dd HH:mm:ss"
+      column_name   = "timestamp"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
+
+func testAccAnomalyDetectorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_baseCloudWatch(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name = %[1]q
+
+  anomaly_detector_config {
+    anomaly_detector_frequency = "PT1H"
+  }
+
+  metric_set {
+    name           = %[1]q
+    dimension_list = ["InstanceId"]
+
+    metric {
+      aggregation_function = "AVG"
+      metric_name          = "CPUUtilization"
+      namespace            = "AWS/EC2"
+    }
+
+    metric_source {
+      cloudwatch_config {
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAnomalyDetectorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAnomalyDetectorConfig_baseCloudWatch(rName), fmt.Sprintf(`
+resource "aws_lookoutmetrics_anomaly_detector" "test" {
+  name = %[1]q
+
+  anomaly_detector_config {
+    anomaly_detector_frequency = "PT1H"
+  }
+
+  metric_set {
+    name           = %[1]q
+    dimension_list = ["InstanceId"]
+
+    metric {
+      aggregation_function = "AVG"
+      metric_name          = "CPUUtilization"
+      namespace            = "AWS/EC2"
+    }
+
+    metric_source {
+      cloudwatch_config {
+        role_arn = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lookoutmetrics
+
+// Exports for use in tests only.
+var (
+	ResourceAlert           = newAlertResource
+	ResourceAnomalyDetector = newAnomalyDetectorResource
+
+	FindAlertByARN           = findAlertByARN
+	FindAnomalyDetectorByARN = findAnomalyDetectorByARN
+)
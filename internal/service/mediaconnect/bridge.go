@@ -0,0 +1,762 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_bridge", name="Bridge")
+func resourceBridge() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBridgeCreate,
+		ReadWithoutTimeout:   resourceBridgeRead,
+		UpdateWithoutTimeout: resourceBridgeUpdate,
+		DeleteWithoutTimeout: resourceBridgeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bridge_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"egress_gateway_bridge": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"egress_gateway_bridge", "ingress_gateway_bridge"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ingress_gateway_bridge": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"egress_gateway_bridge", "ingress_gateway_bridge"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"max_outputs": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrIPAddress: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"network_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrPort: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									names.AttrProtocol: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+									},
+									"ttl": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"placement_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"flow_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"output_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vpc_interface_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"multicast_ip": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"network_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrPort: {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									names.AttrProtocol: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"source_failover_config": sourceFailoverConfigSchema(),
+		},
+	}
+}
+
+func resourceBridgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconnect.CreateBridgeInput{
+		Name:         aws.String(name),
+		PlacementArn: aws.String(d.Get("placement_arn").(string)),
+		Sources:      expandAddBridgeSourceRequests(d.Get(names.AttrSource).([]interface{})),
+	}
+
+	if v, ok := d.GetOk("egress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EgressGatewayBridge = &awstypes.AddEgressGatewayBridgeRequest{
+			MaxBitrate: aws.Int32(int32(v.([]interface{})[0].(map[string]interface{})["max_bitrate"].(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("ingress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		input.IngressGatewayBridge = &awstypes.AddIngressGatewayBridgeRequest{
+			MaxBitrate: aws.Int32(int32(tfMap["max_bitrate"].(int))),
+			MaxOutputs: aws.Int32(int32(tfMap["max_outputs"].(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddBridgeOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateBridge(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Bridge (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Bridge.BridgeArn))
+
+	if _, err := waitBridgeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Bridge (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBridgeRead(ctx, d, meta)...)
+}
+
+func resourceBridgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	bridge, err := findBridgeByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Bridge (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Bridge (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, bridge.BridgeArn)
+	d.Set("bridge_state", bridge.BridgeState)
+	if v := bridge.EgressGatewayBridge; v != nil {
+		if err := d.Set("egress_gateway_bridge", []interface{}{map[string]interface{}{
+			"instance_id": aws.ToString(v.InstanceId),
+			"max_bitrate": aws.ToInt32(v.MaxBitrate),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting egress_gateway_bridge: %s", err)
+		}
+	} else {
+		d.Set("egress_gateway_bridge", nil)
+	}
+	if v := bridge.IngressGatewayBridge; v != nil {
+		if err := d.Set("ingress_gateway_bridge", []interface{}{map[string]interface{}{
+			"instance_id": aws.ToString(v.InstanceId),
+			"max_bitrate": aws.ToInt32(v.MaxBitrate),
+			"max_outputs": aws.ToInt32(v.MaxOutputs),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ingress_gateway_bridge: %s", err)
+		}
+	} else {
+		d.Set("ingress_gateway_bridge", nil)
+	}
+	d.Set(names.AttrName, bridge.Name)
+	if err := d.Set("output", flattenBridgeOutputs(orderByName(d.Get("output").([]interface{}), names.AttrName, bridge.Outputs, bridgeOutputName))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	d.Set("placement_arn", bridge.PlacementArn)
+	if err := d.Set(names.AttrSource, flattenBridgeSources(orderByName(d.Get(names.AttrSource).([]interface{}), names.AttrName, bridge.Sources, bridgeSourceName))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	if bridge.SourceFailoverConfig != nil {
+		if err := d.Set("source_failover_config", []interface{}{flattenFailoverConfig(bridge.SourceFailoverConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting source_failover_config: %s", err)
+		}
+	} else {
+		d.Set("source_failover_config", nil)
+	}
+
+	return diags
+}
+
+func resourceBridgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	if d.HasChanges("egress_gateway_bridge", "ingress_gateway_bridge", "source_failover_config") {
+		input := &mediaconnect.UpdateBridgeInput{
+			BridgeArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("egress_gateway_bridge") {
+			if v, ok := d.GetOk("egress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.EgressGatewayBridge = &awstypes.UpdateEgressGatewayBridgeRequest{
+					MaxBitrate: aws.Int32(int32(v.([]interface{})[0].(map[string]interface{})["max_bitrate"].(int))),
+				}
+			}
+		}
+
+		if d.HasChange("ingress_gateway_bridge") {
+			if v, ok := d.GetOk("ingress_gateway_bridge"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+				input.IngressGatewayBridge = &awstypes.UpdateIngressGatewayBridgeRequest{
+					MaxBitrate: aws.Int32(int32(tfMap["max_bitrate"].(int))),
+					MaxOutputs: aws.Int32(int32(tfMap["max_outputs"].(int))),
+				}
+			}
+		}
+
+		if d.HasChange("source_failover_config") {
+			if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SourceFailoverConfig = expandUpdateFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+					State: awstypes.StateDisabled,
+				}
+			}
+		}
+
+		if _, err := conn.UpdateBridge(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaConnect Bridge (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrSource) {
+		o, n := d.GetChange(names.AttrSource)
+		add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), names.AttrName)
+
+		for _, tfMap := range del {
+			name := tfMap[names.AttrName].(string)
+			_, err := conn.RemoveBridgeSource(ctx, &mediaconnect.RemoveBridgeSourceInput{
+				BridgeArn:  aws.String(d.Id()),
+				SourceName: aws.String(name),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing MediaConnect Bridge (%s) source (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		if len(add) > 0 {
+			_, err := conn.AddBridgeSources(ctx, &mediaconnect.AddBridgeSourcesInput{
+				BridgeArn: aws.String(d.Id()),
+				Sources:   expandAddBridgeSourceRequests(toInterfaceSlice(add)),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding MediaConnect Bridge (%s) sources: %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range update {
+			name := v[1][names.AttrName].(string)
+			apiObject := expandAddBridgeSourceRequest(v[1])
+			input := &mediaconnect.UpdateBridgeSourceInput{
+				BridgeArn:  aws.String(d.Id()),
+				SourceName: aws.String(name),
+			}
+
+			if v := apiObject.FlowSource; v != nil {
+				input.FlowSource = &awstypes.UpdateBridgeFlowSourceRequest{
+					FlowArn:                    v.FlowArn,
+					FlowVpcInterfaceAttachment: v.FlowVpcInterfaceAttachment,
+				}
+			}
+
+			if v := apiObject.NetworkSource; v != nil {
+				input.NetworkSource = &awstypes.UpdateBridgeNetworkSourceRequest{
+					MulticastIp: v.MulticastIp,
+					NetworkName: v.NetworkName,
+					Port:        v.Port,
+					Protocol:    v.Protocol,
+				}
+			}
+
+			if _, err := conn.UpdateBridgeSource(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Bridge (%s) source (%s): %s", d.Id(), name, err)
+			}
+		}
+	}
+
+	if d.HasChange("output") {
+		o, n := d.GetChange("output")
+		add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), names.AttrName)
+
+		for _, tfMap := range del {
+			name := tfMap[names.AttrName].(string)
+			_, err := conn.RemoveBridgeOutput(ctx, &mediaconnect.RemoveBridgeOutputInput{
+				BridgeArn:  aws.String(d.Id()),
+				OutputName: aws.String(name),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing MediaConnect Bridge (%s) output (%s): %s", d.Id(), name, err)
+			}
+		}
+
+		if len(add) > 0 {
+			_, err := conn.AddBridgeOutputs(ctx, &mediaconnect.AddBridgeOutputsInput{
+				BridgeArn: aws.String(d.Id()),
+				Outputs:   expandAddBridgeOutputRequests(toInterfaceSlice(add)),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "adding MediaConnect Bridge (%s) outputs: %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range update {
+			name := v[1][names.AttrName].(string)
+			apiObject := expandAddBridgeOutputRequest(v[1]).NetworkOutput
+			input := &mediaconnect.UpdateBridgeOutputInput{
+				BridgeArn: aws.String(d.Id()),
+				NetworkOutput: &awstypes.UpdateBridgeNetworkOutputRequest{
+					IpAddress:   apiObject.IpAddress,
+					NetworkName: apiObject.NetworkName,
+					Port:        apiObject.Port,
+					Protocol:    apiObject.Protocol,
+					Ttl:         apiObject.Ttl,
+				},
+				OutputName: aws.String(name),
+			}
+
+			if _, err := conn.UpdateBridgeOutput(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Bridge (%s) output (%s): %s", d.Id(), name, err)
+			}
+		}
+	}
+
+	if _, err := waitBridgeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Bridge (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBridgeRead(ctx, d, meta)...)
+}
+
+func resourceBridgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	log.Printf("[DEBUG] Deleting MediaConnect Bridge: %s", d.Id())
+	_, err := conn.DeleteBridge(ctx, &mediaconnect.DeleteBridgeInput{
+		BridgeArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Bridge (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBridgeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Bridge (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findBridgeByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Bridge, error) {
+	input := &mediaconnect.DescribeBridgeInput{
+		BridgeArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeBridge(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Bridge == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.Bridge.BridgeState; state == awstypes.BridgeStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output.Bridge, nil
+}
+
+func statusBridge(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBridgeByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BridgeState), nil
+	}
+}
+
+func waitBridgeCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateCreating),
+		Target:  enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting, awstypes.BridgeStateActive),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateUpdating),
+		Target:  enum.Slice(awstypes.BridgeStateStandby, awstypes.BridgeStateStartPending, awstypes.BridgeStateDeploying, awstypes.BridgeStateStarting, awstypes.BridgeStateActive),
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBridgeDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Bridge, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BridgeStateDeleting, awstypes.BridgeStateStopping, awstypes.BridgeStateStandby),
+		Target:  []string{},
+		Refresh: statusBridge(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Bridge); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func bridgeSourceName(apiObject awstypes.BridgeSource) string {
+	if v := apiObject.FlowSource; v != nil {
+		return aws.ToString(v.Name)
+	}
+
+	if v := apiObject.NetworkSource; v != nil {
+		return aws.ToString(v.Name)
+	}
+
+	return ""
+}
+
+func bridgeOutputName(apiObject awstypes.BridgeOutput) string {
+	if v := apiObject.NetworkOutput; v != nil {
+		return aws.ToString(v.Name)
+	}
+
+	if v := apiObject.FlowOutput; v != nil {
+		return aws.ToString(v.Name)
+	}
+
+	return ""
+}
+
+func expandAddBridgeSourceRequest(tfMap map[string]interface{}) awstypes.AddBridgeSourceRequest {
+	apiObject := awstypes.AddBridgeSourceRequest{}
+	name := tfMap[names.AttrName].(string)
+
+	if v, ok := tfMap["flow_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.FlowSource = &awstypes.AddBridgeFlowSourceRequest{
+			FlowArn: aws.String(tfMap["flow_arn"].(string)),
+			Name:    aws.String(name),
+		}
+
+		if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+			apiObject.FlowSource.FlowVpcInterfaceAttachment = &awstypes.VpcInterfaceAttachment{
+				VpcInterfaceName: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["network_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.NetworkSource = &awstypes.AddBridgeNetworkSourceRequest{
+			MulticastIp: aws.String(tfMap["multicast_ip"].(string)),
+			Name:        aws.String(name),
+			NetworkName: aws.String(tfMap["network_name"].(string)),
+			Port:        aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			Protocol:    awstypes.Protocol(tfMap[names.AttrProtocol].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAddBridgeSourceRequests(tfList []interface{}) []awstypes.AddBridgeSourceRequest {
+	var apiObjects []awstypes.AddBridgeSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddBridgeSourceRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandAddBridgeOutputRequest(tfMap map[string]interface{}) awstypes.AddBridgeOutputRequest {
+	apiObject := awstypes.AddBridgeOutputRequest{}
+
+	if v, ok := tfMap["network_output"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		name := tfMap[names.AttrName].(string)
+		tfMap := v[0].(map[string]interface{})
+		apiObject.NetworkOutput = &awstypes.AddBridgeNetworkOutputRequest{
+			IpAddress:   aws.String(tfMap[names.AttrIPAddress].(string)),
+			Name:        aws.String(name),
+			NetworkName: aws.String(tfMap["network_name"].(string)),
+			Port:        aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			Protocol:    awstypes.Protocol(tfMap[names.AttrProtocol].(string)),
+			Ttl:         aws.Int32(int32(tfMap["ttl"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAddBridgeOutputRequests(tfList []interface{}) []awstypes.AddBridgeOutputRequest {
+	var apiObjects []awstypes.AddBridgeOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddBridgeOutputRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenBridgeSources(apiObjects []awstypes.BridgeSource) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName: bridgeSourceName(apiObject),
+		}
+
+		if v := apiObject.FlowSource; v != nil {
+			flowSource := map[string]interface{}{
+				"flow_arn":   aws.ToString(v.FlowArn),
+				"output_arn": aws.ToString(v.OutputArn),
+			}
+
+			if v := v.FlowVpcInterfaceAttachment; v != nil {
+				flowSource["vpc_interface_name"] = aws.ToString(v.VpcInterfaceName)
+			}
+
+			tfMap["flow_source"] = []interface{}{flowSource}
+		}
+
+		if v := apiObject.NetworkSource; v != nil {
+			tfMap["network_source"] = []interface{}{map[string]interface{}{
+				"multicast_ip":     aws.ToString(v.MulticastIp),
+				"network_name":     aws.ToString(v.NetworkName),
+				names.AttrPort:     aws.ToInt32(v.Port),
+				names.AttrProtocol: string(v.Protocol),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenBridgeOutputs(apiObjects []awstypes.BridgeOutput) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		// Flow outputs are managed by the flow that the bridge is attached to.
+		v := apiObject.NetworkOutput
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.ToString(v.Name),
+			"network_output": []interface{}{map[string]interface{}{
+				names.AttrIPAddress: aws.ToString(v.IpAddress),
+				"network_name":      aws.ToString(v.NetworkName),
+				names.AttrPort:      aws.ToInt32(v.Port),
+				names.AttrProtocol:  string(v.Protocol),
+				"ttl":               aws.ToInt32(v.Ttl),
+			}},
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectBridge_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Bridge
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_bridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName, 239),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconnect", regexache.MustCompile(`bridge:.+:`+rName)),
+					resource.TestCheckResourceAttr(resourceName, "egress_gateway_bridge.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.0.max_bitrate", "10000000"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_gateway_bridge.0.max_outputs", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "placement_arn", "aws_mediaconnect_gateway.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.multicast_ip", "239.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.network_name", "network"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.protocol", "udp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBridgeConfig_basic(rName, 238),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source.0.network_source.0.multicast_ip", "238.0.0.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectBridge_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Bridge
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_bridge.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBridgeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeConfig_basic(rName, 239),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBridgeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceBridge(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBridgeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_bridge" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Bridge %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBridgeExists(ctx context.Context, n string, v *awstypes.Bridge) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindBridgeByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBridgeConfig_basic(rName string, multicastOctet int) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_basic(rName), fmt.Sprintf(`
+resource "aws_mediaconnect_bridge" "test" {
+  name          = %[1]q
+  placement_arn = aws_mediaconnect_gateway.test.arn
+
+  ingress_gateway_bridge {
+    max_bitrate = 10000000
+    max_outputs = 2
+  }
+
+  source {
+    name = "source"
+
+    network_source {
+      multicast_ip = "%[2]d.0.0.1"
+      network_name = "network"
+      port         = 5000
+      protocol     = "udp"
+    }
+  }
+}
+`, rName, multicastOctet))
+}
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+// Exports for use in tests only.
+var (
+	ResourceBridge  = resourceBridge
+	ResourceFlow    = resourceFlow
+	ResourceGateway = resourceGateway
+
+	FindBridgeByARN  = findBridgeByARN
+	FindFlowByARN    = findFlowByARN
+	FindGatewayByARN = findGatewayByARN
+)
@@ -0,0 +1,2229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_flow", name="Flow")
+// @Tags(identifierAttribute="id")
+func resourceFlow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlowCreate,
+		ReadWithoutTimeout:   resourceFlowRead,
+		UpdateWithoutTimeout: resourceFlowUpdate,
+		DeleteWithoutTimeout: resourceFlowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrAvailabilityZone: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_transfer_subscriber_fee_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"encryption": encryptionSchema(),
+						"entitlement_status": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EntitlementStatus](),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+					},
+				},
+			},
+			"media_stream": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fmtp": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"channel_order": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"colorimetry": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.Colorimetry](),
+												},
+												"exact_framerate": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"par": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"range": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.Range](),
+												},
+												"scan_mode": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.ScanMode](),
+												},
+												"tcs": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.Tcs](),
+												},
+											},
+										},
+									},
+									"lang": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"clock_rate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"fmt": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"media_stream_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"media_stream_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_stream_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.MediaStreamType](),
+						},
+						"video_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_allow_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidCIDRNetworkAddress,
+							},
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDestination: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": encryptionSchema(),
+						"listener_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"media_stream_output_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_ip": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"destination_port": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"interface_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"outbound_ip": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"encoding_name": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.EncodingName](),
+									},
+									"encoding_parameters": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compression_factor": {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+												"encoder_profile": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.EncoderProfile](),
+												},
+											},
+										},
+									},
+									"media_stream_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"output_status": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.OutputStatus](),
+						},
+						names.AttrPort: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						names.AttrProtocol: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sender_control_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decryption": encryptionSchema(),
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_sync_buffer": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"media_stream_source_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encoding_name": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.EncodingName](),
+									},
+									"input_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_ip": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"input_port": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"interface_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"media_stream_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"min_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrProtocol: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+						},
+						"sender_control_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"sender_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_listener_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_listener_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vpc_interface_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"source_failover_config": sourceFailoverConfigSchema(),
+			names.AttrState: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(awstypes.StatusStandby),
+				ValidateDiagFunc: enum.Validate[flowState](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"vpc_interface": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.NetworkInterfaceType](),
+						},
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flowState is the set of flow statuses that can be configured.
+type flowState string
+
+func (flowState) Values() []flowState {
+	return []flowState{
+		flowState(awstypes.StatusActive),
+		flowState(awstypes.StatusStandby),
+	}
+}
+
+func resourceFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconnect.CreateFlowInput{
+		Name:    aws.String(name),
+		Sources: expandSetSourceRequests(d.Get(names.AttrSource).([]interface{})),
+	}
+
+	if v, ok := d.GetOk(names.AttrAvailabilityZone); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entitlement"); ok && len(v.([]interface{})) > 0 {
+		input.Entitlements = expandGrantEntitlementRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("media_stream"); ok && len(v.([]interface{})) > 0 {
+		input.MediaStreams = expandAddMediaStreamRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output"); ok && len(v.([]interface{})) > 0 {
+		input.Outputs = expandAddOutputRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceFailoverConfig = expandFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_interface"); ok && len(v.([]interface{})) > 0 {
+		input.VpcInterfaces = expandVPCInterfaceRequests(v.([]interface{}))
+	}
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Flow.FlowArn))
+
+	flow, err := waitFlowCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) create: %s", d.Id(), err)
+	}
+
+	// Flows are always created in standby.
+	if err := updateFlowState(ctx, conn, d.Id(), string(flow.Status), d.Get(names.AttrState).(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := createTags(ctx, conn, d.Id(), getTagsIn(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting MediaConnect Flow (%s) tags: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlowRead(ctx, d, meta)...)
+}
+
+func resourceFlowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	flow, err := findFlowByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, flow.FlowArn)
+	d.Set(names.AttrAvailabilityZone, flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+	if err := d.Set("entitlement", flattenEntitlements(orderByName(d.Get("entitlement").([]interface{}), names.AttrName, flow.Entitlements, func(v awstypes.Entitlement) string {
+		return aws.ToString(v.Name)
+	}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entitlement: %s", err)
+	}
+	if err := d.Set("media_stream", flattenMediaStreams(orderByName(d.Get("media_stream").([]interface{}), "media_stream_name", flow.MediaStreams, func(v awstypes.MediaStream) string {
+		return aws.ToString(v.MediaStreamName)
+	}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting media_stream: %s", err)
+	}
+	d.Set(names.AttrName, flow.Name)
+	if err := d.Set("output", flattenOutputs(orderByName(d.Get("output").([]interface{}), names.AttrName, flow.Outputs, func(v awstypes.Output) string {
+		return aws.ToString(v.Name)
+	}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	sources := flow.Sources
+	if len(sources) == 0 && flow.Source != nil {
+		sources = []awstypes.Source{*flow.Source}
+	}
+	if err := d.Set(names.AttrSource, flattenSources(orderByName(d.Get(names.AttrSource).([]interface{}), names.AttrName, sources, func(v awstypes.Source) string {
+		return aws.ToString(v.Name)
+	}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	if flow.SourceFailoverConfig != nil {
+		if err := d.Set("source_failover_config", []interface{}{flattenFailoverConfig(flow.SourceFailoverConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting source_failover_config: %s", err)
+		}
+	} else {
+		d.Set("source_failover_config", nil)
+	}
+	d.Set(names.AttrState, flow.Status)
+	if err := d.Set("vpc_interface", flattenVPCInterfaces(orderByName(d.Get("vpc_interface").([]interface{}), names.AttrName, flow.VpcInterfaces, func(v awstypes.VpcInterface) string {
+		return aws.ToString(v.Name)
+	}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_interface: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFlowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	o, n := d.GetChange(names.AttrState)
+	currentState, configuredState := o.(string), n.(string)
+
+	// Stop the flow before making any other changes.
+	if configuredState == string(awstypes.StatusStandby) {
+		if err := updateFlowState(ctx, conn, d.Id(), currentState, configuredState, timeout); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrState, names.AttrTags, names.AttrTagsAll) {
+		if err := updateFlowVPCInterfaces(ctx, conn, d, false); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := updateFlowMediaStreams(ctx, conn, d, false); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := updateFlowSources(ctx, conn, d); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := updateFlowOutputs(ctx, conn, d); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := updateFlowEntitlements(ctx, conn, d); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if d.HasChange("source_failover_config") {
+			input := &mediaconnect.UpdateFlowInput{
+				FlowArn: aws.String(d.Id()),
+			}
+
+			if v, ok := d.GetOk("source_failover_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SourceFailoverConfig = expandUpdateFailoverConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.SourceFailoverConfig = &awstypes.UpdateFailoverConfig{
+					State: awstypes.StateDisabled,
+				}
+			}
+
+			if _, err := conn.UpdateFlow(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MediaConnect Flow (%s): %s", d.Id(), err)
+			}
+		}
+
+		// Media streams and VPC interfaces can only be removed once nothing references them.
+		if err := updateFlowMediaStreams(ctx, conn, d, true); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if err := updateFlowVPCInterfaces(ctx, conn, d, true); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		flow, err := waitFlowUpdated(ctx, conn, d.Id(), timeout)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) update: %s", d.Id(), err)
+		}
+
+		currentState = string(flow.Status)
+	}
+
+	// Start the flow once all other changes have been made.
+	if configuredState == string(awstypes.StatusActive) {
+		if err := updateFlowState(ctx, conn, d.Id(), currentState, configuredState, timeout); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceFlowRead(ctx, d, meta)...)
+}
+
+func resourceFlowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	if err := updateFlowState(ctx, conn, d.Id(), d.Get(names.AttrState).(string), string(awstypes.StatusStandby), timeout); err != nil {
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return diags
+		}
+
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow: %s", d.Id())
+	_, err := conn.DeleteFlow(ctx, &mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlowDeleted(ctx, conn, d.Id(), timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Flow (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateFlowState(ctx context.Context, conn *mediaconnect.Client, arn string, currentState, configuredState string, timeout time.Duration) error {
+	if currentState == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case string(awstypes.StatusActive):
+		if _, err := conn.StartFlow(ctx, &mediaconnect.StartFlowInput{
+			FlowArn: aws.String(arn),
+		}); err != nil {
+			return fmt.Errorf("starting MediaConnect Flow (%s): %w", arn, err)
+		}
+
+		if _, err := waitFlowStarted(ctx, conn, arn, timeout); err != nil {
+			return fmt.Errorf("waiting for MediaConnect Flow (%s) start: %w", arn, err)
+		}
+
+	case string(awstypes.StatusStandby):
+		if _, err := conn.StopFlow(ctx, &mediaconnect.StopFlowInput{
+			FlowArn: aws.String(arn),
+		}); err != nil {
+			return fmt.Errorf("stopping MediaConnect Flow (%s): %w", arn, err)
+		}
+
+		if _, err := waitFlowStopped(ctx, conn, arn, timeout); err != nil {
+			return fmt.Errorf("waiting for MediaConnect Flow (%s) stop: %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
+func updateFlowVPCInterfaces(ctx context.Context, conn *mediaconnect.Client, d *schema.ResourceData, remove bool) error {
+	if !d.HasChange("vpc_interface") {
+		return nil
+	}
+
+	o, n := d.GetChange("vpc_interface")
+	add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), names.AttrName)
+
+	// VPC interfaces can't be updated in place.
+	for _, v := range update {
+		add = append(add, v[1])
+		del = append(del, v[0])
+	}
+
+	if remove {
+		for _, tfMap := range del {
+			name := tfMap[names.AttrName].(string)
+			_, err := conn.RemoveFlowVpcInterface(ctx, &mediaconnect.RemoveFlowVpcInterfaceInput{
+				FlowArn:          aws.String(d.Id()),
+				VpcInterfaceName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("removing MediaConnect Flow (%s) VPC interface (%s): %w", d.Id(), name, err)
+			}
+		}
+
+		return nil
+	}
+
+	if len(add) > 0 {
+		// Re-created VPC interfaces are removed before they are added back.
+		for _, v := range update {
+			name := v[0][names.AttrName].(string)
+			_, err := conn.RemoveFlowVpcInterface(ctx, &mediaconnect.RemoveFlowVpcInterfaceInput{
+				FlowArn:          aws.String(d.Id()),
+				VpcInterfaceName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("removing MediaConnect Flow (%s) VPC interface (%s): %w", d.Id(), name, err)
+			}
+		}
+
+		_, err := conn.AddFlowVpcInterfaces(ctx, &mediaconnect.AddFlowVpcInterfacesInput{
+			FlowArn:       aws.String(d.Id()),
+			VpcInterfaces: expandVPCInterfaceRequests(toInterfaceSlice(add)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding MediaConnect Flow (%s) VPC interfaces: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func updateFlowMediaStreams(ctx context.Context, conn *mediaconnect.Client, d *schema.ResourceData, remove bool) error {
+	if !d.HasChange("media_stream") {
+		return nil
+	}
+
+	o, n := d.GetChange("media_stream")
+	add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), "media_stream_name")
+
+	// A media stream's ID can't be updated in place.
+	var recreate []string
+	for i := 0; i < len(update); i++ {
+		if old, new := update[i][0], update[i][1]; old["media_stream_id"] != new["media_stream_id"] {
+			recreate = append(recreate, old["media_stream_name"].(string))
+			add = append(add, new)
+			update = append(update[:i], update[i+1:]...)
+			i--
+		}
+	}
+
+	if remove {
+		for _, tfMap := range del {
+			name := tfMap["media_stream_name"].(string)
+			_, err := conn.RemoveFlowMediaStream(ctx, &mediaconnect.RemoveFlowMediaStreamInput{
+				FlowArn:         aws.String(d.Id()),
+				MediaStreamName: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("removing MediaConnect Flow (%s) media stream (%s): %w", d.Id(), name, err)
+			}
+		}
+
+		return nil
+	}
+
+	for _, name := range recreate {
+		_, err := conn.RemoveFlowMediaStream(ctx, &mediaconnect.RemoveFlowMediaStreamInput{
+			FlowArn:         aws.String(d.Id()),
+			MediaStreamName: aws.String(name),
+		})
+
+		if err != nil {
+			return fmt.Errorf("removing MediaConnect Flow (%s) media stream (%s): %w", d.Id(), name, err)
+		}
+	}
+
+	if len(add) > 0 {
+		_, err := conn.AddFlowMediaStreams(ctx, &mediaconnect.AddFlowMediaStreamsInput{
+			FlowArn:      aws.String(d.Id()),
+			MediaStreams: expandAddMediaStreamRequests(toInterfaceSlice(add)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding MediaConnect Flow (%s) media streams: %w", d.Id(), err)
+		}
+	}
+
+	for _, v := range update {
+		tfMap := v[1]
+		name := tfMap["media_stream_name"].(string)
+		apiObject := expandAddMediaStreamRequest(tfMap)
+		input := &mediaconnect.UpdateFlowMediaStreamInput{
+			Attributes:      apiObject.Attributes,
+			ClockRate:       apiObject.ClockRate,
+			Description:     apiObject.Description,
+			FlowArn:         aws.String(d.Id()),
+			MediaStreamName: aws.String(name),
+			MediaStreamType: apiObject.MediaStreamType,
+			VideoFormat:     apiObject.VideoFormat,
+		}
+
+		if _, err := conn.UpdateFlowMediaStream(ctx, input); err != nil {
+			return fmt.Errorf("updating MediaConnect Flow (%s) media stream (%s): %w", d.Id(), name, err)
+		}
+	}
+
+	return nil
+}
+
+func updateFlowSources(ctx context.Context, conn *mediaconnect.Client, d *schema.ResourceData) error {
+	if !d.HasChange(names.AttrSource) {
+		return nil
+	}
+
+	o, n := d.GetChange(names.AttrSource)
+	add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), names.AttrName)
+
+	for _, tfMap := range del {
+		arn := tfMap[names.AttrARN].(string)
+		_, err := conn.RemoveFlowSource(ctx, &mediaconnect.RemoveFlowSourceInput{
+			FlowArn:   aws.String(d.Id()),
+			SourceArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("removing MediaConnect Flow (%s) source (%s): %w", d.Id(), arn, err)
+		}
+	}
+
+	if len(add) > 0 {
+		_, err := conn.AddFlowSources(ctx, &mediaconnect.AddFlowSourcesInput{
+			FlowArn: aws.String(d.Id()),
+			Sources: expandSetSourceRequests(toInterfaceSlice(add)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding MediaConnect Flow (%s) sources: %w", d.Id(), err)
+		}
+	}
+
+	for _, v := range update {
+		arn := v[0][names.AttrARN].(string)
+		apiObject := expandSetSourceRequest(v[1])
+		input := &mediaconnect.UpdateFlowSourceInput{
+			Decryption:                      expandUpdateEncryption(apiObject.Decryption),
+			Description:                     apiObject.Description,
+			EntitlementArn:                  apiObject.EntitlementArn,
+			FlowArn:                         aws.String(d.Id()),
+			IngestPort:                      apiObject.IngestPort,
+			MaxBitrate:                      apiObject.MaxBitrate,
+			MaxLatency:                      apiObject.MaxLatency,
+			MaxSyncBuffer:                   apiObject.MaxSyncBuffer,
+			MediaStreamSourceConfigurations: apiObject.MediaStreamSourceConfigurations,
+			MinLatency:                      apiObject.MinLatency,
+			Protocol:                        apiObject.Protocol,
+			SenderControlPort:               apiObject.SenderControlPort,
+			SenderIpAddress:                 apiObject.SenderIpAddress,
+			SourceArn:                       aws.String(arn),
+			SourceListenerAddress:           apiObject.SourceListenerAddress,
+			SourceListenerPort:              apiObject.SourceListenerPort,
+			StreamId:                        apiObject.StreamId,
+			VpcInterfaceName:                apiObject.VpcInterfaceName,
+			WhitelistCidr:                   apiObject.WhitelistCidr,
+		}
+
+		if _, err := conn.UpdateFlowSource(ctx, input); err != nil {
+			return fmt.Errorf("updating MediaConnect Flow (%s) source (%s): %w", d.Id(), arn, err)
+		}
+	}
+
+	return nil
+}
+
+func updateFlowOutputs(ctx context.Context, conn *mediaconnect.Client, d *schema.ResourceData) error {
+	if !d.HasChange("output") {
+		return nil
+	}
+
+	o, n := d.GetChange("output")
+	add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), names.AttrName)
+
+	for _, tfMap := range del {
+		arn := tfMap[names.AttrARN].(string)
+		_, err := conn.RemoveFlowOutput(ctx, &mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(d.Id()),
+			OutputArn: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("removing MediaConnect Flow (%s) output (%s): %w", d.Id(), arn, err)
+		}
+	}
+
+	if len(add) > 0 {
+		_, err := conn.AddFlowOutputs(ctx, &mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(d.Id()),
+			Outputs: expandAddOutputRequests(toInterfaceSlice(add)),
+		})
+
+		if err != nil {
+			return fmt.Errorf("adding MediaConnect Flow (%s) outputs: %w", d.Id(), err)
+		}
+	}
+
+	for _, v := range update {
+		arn := v[0][names.AttrARN].(string)
+		apiObject := expandAddOutputRequest(v[1])
+		input := &mediaconnect.UpdateFlowOutputInput{
+			CidrAllowList:                   apiObject.CidrAllowList,
+			Description:                     apiObject.Description,
+			Destination:                     apiObject.Destination,
+			Encryption:                      expandUpdateEncryption(apiObject.Encryption),
+			FlowArn:                         aws.String(d.Id()),
+			MaxLatency:                      apiObject.MaxLatency,
+			MediaStreamOutputConfigurations: apiObject.MediaStreamOutputConfigurations,
+			MinLatency:                      apiObject.MinLatency,
+			OutputArn:                       aws.String(arn),
+			OutputStatus:                    apiObject.OutputStatus,
+			Port:                            apiObject.Port,
+			Protocol:                        apiObject.Protocol,
+			RemoteId:                        apiObject.RemoteId,
+			SenderControlPort:               apiObject.SenderControlPort,
+			SmoothingLatency:                apiObject.SmoothingLatency,
+			StreamId:                        apiObject.StreamId,
+			VpcInterfaceAttachment:          apiObject.VpcInterfaceAttachment,
+		}
+
+		if _, err := conn.UpdateFlowOutput(ctx, input); err != nil {
+			return fmt.Errorf("updating MediaConnect Flow (%s) output (%s): %w", d.Id(), arn, err)
+		}
+	}
+
+	return nil
+}
+
+func updateFlowEntitlements(ctx context.Context, conn *mediaconnect.Client, d *schema.ResourceData) error {
+	if !d.HasChange("entitlement") {
+		return nil
+	}
+
+	o, n := d.GetChange("entitlement")
+	add, del, update := namedBlockChanges(o.([]interface{}), n.([]interface{}), names.AttrName)
+
+	// An entitlement's data transfer subscriber fee can't be updated in place.
+	for i := 0; i < len(update); i++ {
+		if old, new := update[i][0], update[i][1]; old["data_transfer_subscriber_fee_percent"] != new["data_transfer_subscriber_fee_percent"] {
+			add = append(add, new)
+			del = append(del, old)
+			update = append(update[:i], update[i+1:]...)
+			i--
+		}
+	}
+
+	for _, tfMap := range del {
+		arn := tfMap[names.AttrARN].(string)
+		_, err := conn.RevokeFlowEntitlement(ctx, &mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: aws.String(arn),
+			FlowArn:        aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("revoking MediaConnect Flow (%s) entitlement (%s): %w", d.Id(), arn, err)
+		}
+	}
+
+	if len(add) > 0 {
+		_, err := conn.GrantFlowEntitlements(ctx, &mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: expandGrantEntitlementRequests(toInterfaceSlice(add)),
+			FlowArn:      aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("granting MediaConnect Flow (%s) entitlements: %w", d.Id(), err)
+		}
+	}
+
+	for _, v := range update {
+		arn := v[0][names.AttrARN].(string)
+		apiObject := expandGrantEntitlementRequest(v[1])
+		input := &mediaconnect.UpdateFlowEntitlementInput{
+			Description:       apiObject.Description,
+			Encryption:        expandUpdateEncryption(apiObject.Encryption),
+			EntitlementArn:    aws.String(arn),
+			EntitlementStatus: apiObject.EntitlementStatus,
+			FlowArn:           aws.String(d.Id()),
+			Subscribers:       apiObject.Subscribers,
+		}
+
+		if _, err := conn.UpdateFlowEntitlement(ctx, input); err != nil {
+			return fmt.Errorf("updating MediaConnect Flow (%s) entitlement (%s): %w", d.Id(), arn, err)
+		}
+	}
+
+	return nil
+}
+
+func findFlowByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Flow, error) {
+	input := &mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlow(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Flow == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Flow, nil
+}
+
+func statusFlow(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowUpdated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby, awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStarted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusStandby, awstypes.StatusStarting, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusActive),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowStopped(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusActive, awstypes.StatusStopping, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusStandby),
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlowDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Flow, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusDeleting, awstypes.StatusStandby),
+		Target:  []string{},
+		Refresh: statusFlow(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Flow); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// namedBlockChanges compares the old and new values of a list of configuration blocks keyed by the specified attribute.
+// Changed blocks are returned as [old, new] pairs. Computed attributes are ignored when comparing blocks.
+func namedBlockChanges(o, n []interface{}, key string) ([]map[string]interface{}, []map[string]interface{}, [][2]map[string]interface{}) {
+	var add, del []map[string]interface{}
+	var update [][2]map[string]interface{}
+
+	om := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			om[tfMap[key].(string)] = tfMap
+		}
+	}
+
+	nm := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range n {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			name := tfMap[key].(string)
+			nm[name] = tfMap
+
+			if old, ok := om[name]; !ok {
+				add = append(add, tfMap)
+			} else if !reflect.DeepEqual(withoutComputed(old), withoutComputed(tfMap)) {
+				update = append(update, [2]map[string]interface{}{old, tfMap})
+			}
+		}
+	}
+
+	for name, tfMap := range om {
+		if _, ok := nm[name]; !ok {
+			del = append(del, tfMap)
+		}
+	}
+
+	return add, del, update
+}
+
+func withoutComputed(tfMap map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(tfMap))
+
+	for k, v := range tfMap {
+		switch k {
+		case names.AttrARN, "fmt", "ingest_ip", "listener_address", "network_interface_ids":
+			continue
+		}
+
+		if v, ok := v.(*schema.Set); ok {
+			m[k] = v.List()
+			continue
+		}
+
+		m[k] = v
+	}
+
+	return m
+}
+
+func toInterfaceSlice(tfMaps []map[string]interface{}) []interface{} {
+	tfList := make([]interface{}, 0, len(tfMaps))
+
+	for _, tfMap := range tfMaps {
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// orderByName orders apiObjects to match the order of the configuration blocks in tfList, keyed by the specified attribute.
+// API objects not present in the configuration are appended in the order returned by the API.
+func orderByName[T any](tfList []interface{}, key string, apiObjects []T, name func(T) string) []T {
+	if len(tfList) == 0 {
+		return apiObjects
+	}
+
+	var ordered, unordered []T
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, apiObject := range apiObjects {
+			if v := name(apiObject); v == tfMap[key] && !seen[v] {
+				ordered = append(ordered, apiObject)
+				seen[v] = true
+			}
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if !seen[name(apiObject)] {
+			unordered = append(unordered, apiObject)
+		}
+	}
+
+	return append(ordered, unordered...)
+}
+
+func encryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Algorithm](),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.KeyType](),
+				},
+				names.AttrRegion: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrResourceID: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrURL: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func sourceFailoverConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failover_mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.FailoverMode](),
+				},
+				"recovery_window": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"source_priority": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"primary_source": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				names.AttrState: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.State](),
+				},
+			},
+		},
+	}
+}
+
+func expandEncryption(tfList []interface{}) *awstypes.Encryption {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.Encryption{}
+
+	if v, ok := tfMap["algorithm"].(string); ok && v != "" {
+		apiObject.Algorithm = awstypes.Algorithm(v)
+	}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["device_id"].(string); ok && v != "" {
+		apiObject.DeviceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_type"].(string); ok && v != "" {
+		apiObject.KeyType = awstypes.KeyType(v)
+	}
+
+	if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrResourceID].(string); ok && v != "" {
+		apiObject.ResourceId = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrURL].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateEncryption(apiObject *awstypes.Encryption) *awstypes.UpdateEncryption {
+	if apiObject == nil {
+		return nil
+	}
+
+	return &awstypes.UpdateEncryption{
+		Algorithm:                    apiObject.Algorithm,
+		ConstantInitializationVector: apiObject.ConstantInitializationVector,
+		DeviceId:                     apiObject.DeviceId,
+		KeyType:                      apiObject.KeyType,
+		Region:                       apiObject.Region,
+		ResourceId:                   apiObject.ResourceId,
+		RoleArn:                      apiObject.RoleArn,
+		SecretArn:                    apiObject.SecretArn,
+		Url:                          apiObject.Url,
+	}
+}
+
+func expandFailoverConfig(tfMap map[string]interface{}) *awstypes.FailoverConfig {
+	apiObject := &awstypes.FailoverConfig{}
+
+	if v, ok := tfMap["failover_mode"].(string); ok && v != "" {
+		apiObject.FailoverMode = awstypes.FailoverMode(v)
+	}
+
+	if v, ok := tfMap["recovery_window"].(int); ok && v != 0 {
+		apiObject.RecoveryWindow = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["source_priority"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["primary_source"].(string); ok && v != "" {
+			apiObject.SourcePriority = &awstypes.SourcePriority{
+				PrimarySource: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap[names.AttrState].(string); ok && v != "" {
+		apiObject.State = awstypes.State(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateFailoverConfig(tfMap map[string]interface{}) *awstypes.UpdateFailoverConfig {
+	apiObject := expandFailoverConfig(tfMap)
+
+	return &awstypes.UpdateFailoverConfig{
+		FailoverMode:   apiObject.FailoverMode,
+		RecoveryWindow: apiObject.RecoveryWindow,
+		SourcePriority: apiObject.SourcePriority,
+		State:          apiObject.State,
+	}
+}
+
+func expandSetSourceRequest(tfMap map[string]interface{}) awstypes.SetSourceRequest {
+	apiObject := awstypes.SetSourceRequest{
+		Decryption: expandEncryption(tfMap["decryption"].([]interface{})),
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_arn"].(string); ok && v != "" {
+		apiObject.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["ingest_port"].(int); ok && v != 0 {
+		apiObject.IngestPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["max_bitrate"].(int); ok && v != 0 {
+		apiObject.MaxBitrate = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["max_sync_buffer"].(int); ok && v != 0 {
+		apiObject.MaxSyncBuffer = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["media_stream_source_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaStreamSourceConfigurations = expandMediaStreamSourceConfigurationRequests(v)
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+		apiObject.Protocol = awstypes.Protocol(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["sender_ip_address"].(string); ok && v != "" {
+		apiObject.SenderIpAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_address"].(string); ok && v != "" {
+		apiObject.SourceListenerAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_listener_port"].(int); ok && v != 0 {
+		apiObject.SourceListenerPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceName = aws.String(v)
+	}
+
+	if v, ok := tfMap["whitelist_cidr"].(string); ok && v != "" {
+		apiObject.WhitelistCidr = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSetSourceRequests(tfList []interface{}) []awstypes.SetSourceRequest {
+	var apiObjects []awstypes.SetSourceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandSetSourceRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandMediaStreamSourceConfigurationRequests(tfList []interface{}) []awstypes.MediaStreamSourceConfigurationRequest {
+	var apiObjects []awstypes.MediaStreamSourceConfigurationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.MediaStreamSourceConfigurationRequest{
+			EncodingName:    awstypes.EncodingName(tfMap["encoding_name"].(string)),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}
+
+		for _, tfMapRaw := range tfMap["input_configuration"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.InputConfigurations = append(apiObject.InputConfigurations, awstypes.InputConfigurationRequest{
+				InputPort: aws.Int32(int32(tfMap["input_port"].(int))),
+				Interface: &awstypes.InterfaceRequest{
+					Name: aws.String(tfMap["interface_name"].(string)),
+				},
+			})
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAddOutputRequest(tfMap map[string]interface{}) awstypes.AddOutputRequest {
+	apiObject := awstypes.AddOutputRequest{
+		Encryption: expandEncryption(tfMap["encryption"].([]interface{})),
+		Protocol:   awstypes.Protocol(tfMap[names.AttrProtocol].(string)),
+	}
+
+	if v, ok := tfMap["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CidrAllowList = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrDestination].(string); ok && v != "" {
+		apiObject.Destination = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_latency"].(int); ok && v != 0 {
+		apiObject.MaxLatency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["media_stream_output_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.MediaStreamOutputConfigurations = expandMediaStreamOutputConfigurationRequests(v)
+	}
+
+	if v, ok := tfMap["min_latency"].(int); ok && v != 0 {
+		apiObject.MinLatency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_status"].(string); ok && v != "" {
+		apiObject.OutputStatus = awstypes.OutputStatus(v)
+	}
+
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["remote_id"].(string); ok && v != "" {
+		apiObject.RemoteId = aws.String(v)
+	}
+
+	if v, ok := tfMap["sender_control_port"].(int); ok && v != 0 {
+		apiObject.SenderControlPort = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["smoothing_latency"].(int); ok && v != 0 {
+		apiObject.SmoothingLatency = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["stream_id"].(string); ok && v != "" {
+		apiObject.StreamId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_interface_name"].(string); ok && v != "" {
+		apiObject.VpcInterfaceAttachment = &awstypes.VpcInterfaceAttachment{
+			VpcInterfaceName: aws.String(v),
+		}
+	}
+
+	return apiObject
+}
+
+func expandAddOutputRequests(tfList []interface{}) []awstypes.AddOutputRequest {
+	var apiObjects []awstypes.AddOutputRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddOutputRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandMediaStreamOutputConfigurationRequests(tfList []interface{}) []awstypes.MediaStreamOutputConfigurationRequest {
+	var apiObjects []awstypes.MediaStreamOutputConfigurationRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.MediaStreamOutputConfigurationRequest{
+			EncodingName:    awstypes.EncodingName(tfMap["encoding_name"].(string)),
+			MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		}
+
+		for _, tfMapRaw := range tfMap["destination_configuration"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.DestinationConfigurations = append(apiObject.DestinationConfigurations, awstypes.DestinationConfigurationRequest{
+				DestinationIp:   aws.String(tfMap["destination_ip"].(string)),
+				DestinationPort: aws.Int32(int32(tfMap["destination_port"].(int))),
+				Interface: &awstypes.InterfaceRequest{
+					Name: aws.String(tfMap["interface_name"].(string)),
+				},
+			})
+		}
+
+		if v, ok := tfMap["encoding_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.EncodingParameters = &awstypes.EncodingParametersRequest{
+				CompressionFactor: aws.Float64(tfMap["compression_factor"].(float64)),
+			}
+
+			if v, ok := tfMap["encoder_profile"].(string); ok && v != "" {
+				apiObject.EncodingParameters.EncoderProfile = awstypes.EncoderProfile(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGrantEntitlementRequest(tfMap map[string]interface{}) awstypes.GrantEntitlementRequest {
+	apiObject := awstypes.GrantEntitlementRequest{
+		Encryption:  expandEncryption(tfMap["encryption"].([]interface{})),
+		Subscribers: flex.ExpandStringValueSet(tfMap["subscribers"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["data_transfer_subscriber_fee_percent"].(int); ok && v != 0 {
+		apiObject.DataTransferSubscriberFeePercent = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["entitlement_status"].(string); ok && v != "" {
+		apiObject.EntitlementStatus = awstypes.EntitlementStatus(v)
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGrantEntitlementRequests(tfList []interface{}) []awstypes.GrantEntitlementRequest {
+	var apiObjects []awstypes.GrantEntitlementRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandGrantEntitlementRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandAddMediaStreamRequest(tfMap map[string]interface{}) awstypes.AddMediaStreamRequest {
+	apiObject := awstypes.AddMediaStreamRequest{
+		MediaStreamId:   aws.Int32(int32(tfMap["media_stream_id"].(int))),
+		MediaStreamName: aws.String(tfMap["media_stream_name"].(string)),
+		MediaStreamType: awstypes.MediaStreamType(tfMap["media_stream_type"].(string)),
+	}
+
+	if v, ok := tfMap["attributes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Attributes = &awstypes.MediaStreamAttributesRequest{}
+
+		if v, ok := tfMap["fmtp"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Attributes.Fmtp = expandFmtpRequest(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lang"].(string); ok && v != "" {
+			apiObject.Attributes.Lang = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["clock_rate"].(int); ok && v != 0 {
+		apiObject.ClockRate = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["video_format"].(string); ok && v != "" {
+		apiObject.VideoFormat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAddMediaStreamRequests(tfList []interface{}) []awstypes.AddMediaStreamRequest {
+	var apiObjects []awstypes.AddMediaStreamRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandAddMediaStreamRequest(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandFmtpRequest(tfMap map[string]interface{}) *awstypes.FmtpRequest {
+	apiObject := &awstypes.FmtpRequest{}
+
+	if v, ok := tfMap["channel_order"].(string); ok && v != "" {
+		apiObject.ChannelOrder = aws.String(v)
+	}
+
+	if v, ok := tfMap["colorimetry"].(string); ok && v != "" {
+		apiObject.Colorimetry = awstypes.Colorimetry(v)
+	}
+
+	if v, ok := tfMap["exact_framerate"].(string); ok && v != "" {
+		apiObject.ExactFramerate = aws.String(v)
+	}
+
+	if v, ok := tfMap["par"].(string); ok && v != "" {
+		apiObject.Par = aws.String(v)
+	}
+
+	if v, ok := tfMap["range"].(string); ok && v != "" {
+		apiObject.Range = awstypes.Range(v)
+	}
+
+	if v, ok := tfMap["scan_mode"].(string); ok && v != "" {
+		apiObject.ScanMode = awstypes.ScanMode(v)
+	}
+
+	if v, ok := tfMap["tcs"].(string); ok && v != "" {
+		apiObject.Tcs = awstypes.Tcs(v)
+	}
+
+	return apiObject
+}
+
+func expandVPCInterfaceRequests(tfList []interface{}) []awstypes.VpcInterfaceRequest {
+	var apiObjects []awstypes.VpcInterfaceRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.VpcInterfaceRequest{
+			Name:             aws.String(tfMap[names.AttrName].(string)),
+			RoleArn:          aws.String(tfMap[names.AttrRoleARN].(string)),
+			SecurityGroupIds: flex.ExpandStringValueSet(tfMap[names.AttrSecurityGroupIDs].(*schema.Set)),
+			SubnetId:         aws.String(tfMap[names.AttrSubnetID].(string)),
+		}
+
+		if v, ok := tfMap["network_interface_type"].(string); ok && v != "" {
+			apiObject.NetworkInterfaceType = awstypes.NetworkInterfaceType(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEncryption(apiObject *awstypes.Encryption) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm":                      string(apiObject.Algorithm),
+		"constant_initialization_vector": aws.ToString(apiObject.ConstantInitializationVector),
+		"device_id":                      aws.ToString(apiObject.DeviceId),
+		"key_type":                       string(apiObject.KeyType),
+		names.AttrRegion:                 aws.ToString(apiObject.Region),
+		names.AttrResourceID:             aws.ToString(apiObject.ResourceId),
+		names.AttrRoleARN:                aws.ToString(apiObject.RoleArn),
+		"secret_arn":                     aws.ToString(apiObject.SecretArn),
+		names.AttrURL:                    aws.ToString(apiObject.Url),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFailoverConfig(apiObject *awstypes.FailoverConfig) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"failover_mode":   string(apiObject.FailoverMode),
+		"recovery_window": aws.ToInt32(apiObject.RecoveryWindow),
+		names.AttrState:   string(apiObject.State),
+	}
+
+	if v := apiObject.SourcePriority; v != nil && v.PrimarySource != nil {
+		tfMap["source_priority"] = []interface{}{map[string]interface{}{
+			"primary_source": aws.ToString(v.PrimarySource),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenSources(apiObjects []awstypes.Source) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:                       aws.ToString(apiObject.SourceArn),
+			"decryption":                        flattenEncryption(apiObject.Decryption),
+			names.AttrDescription:               aws.ToString(apiObject.Description),
+			"entitlement_arn":                   aws.ToString(apiObject.EntitlementArn),
+			"ingest_ip":                         aws.ToString(apiObject.IngestIp),
+			"ingest_port":                       aws.ToInt32(apiObject.IngestPort),
+			"media_stream_source_configuration": flattenMediaStreamSourceConfigurations(apiObject.MediaStreamSourceConfigurations),
+			names.AttrName:                      aws.ToString(apiObject.Name),
+			"sender_control_port":               aws.ToInt32(apiObject.SenderControlPort),
+			"sender_ip_address":                 aws.ToString(apiObject.SenderIpAddress),
+			"vpc_interface_name":                aws.ToString(apiObject.VpcInterfaceName),
+			"whitelist_cidr":                    aws.ToString(apiObject.WhitelistCidr),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["max_bitrate"] = aws.ToInt32(v.MaxBitrate)
+			tfMap["max_latency"] = aws.ToInt32(v.MaxLatency)
+			tfMap["max_sync_buffer"] = aws.ToInt32(v.MaxSyncBuffer)
+			tfMap["min_latency"] = aws.ToInt32(v.MinLatency)
+			tfMap[names.AttrProtocol] = string(v.Protocol)
+			tfMap["source_listener_address"] = aws.ToString(v.SourceListenerAddress)
+			tfMap["source_listener_port"] = aws.ToInt32(v.SourceListenerPort)
+			tfMap["stream_id"] = aws.ToString(v.StreamId)
+
+			if v.SenderControlPort != nil {
+				tfMap["sender_control_port"] = aws.ToInt32(v.SenderControlPort)
+			}
+			if v.SenderIpAddress != nil {
+				tfMap["sender_ip_address"] = aws.ToString(v.SenderIpAddress)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMediaStreamSourceConfigurations(apiObjects []awstypes.MediaStreamSourceConfiguration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		var inputConfigurations []interface{}
+
+		for _, apiObject := range apiObject.InputConfigurations {
+			tfMap := map[string]interface{}{
+				"input_ip":   aws.ToString(apiObject.InputIp),
+				"input_port": aws.ToInt32(apiObject.InputPort),
+			}
+
+			if v := apiObject.Interface; v != nil {
+				tfMap["interface_name"] = aws.ToString(v.Name)
+			}
+
+			inputConfigurations = append(inputConfigurations, tfMap)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"encoding_name":       string(apiObject.EncodingName),
+			"input_configuration": inputConfigurations,
+			"media_stream_name":   aws.ToString(apiObject.MediaStreamName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputs(apiObjects []awstypes.Output) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:                       aws.ToString(apiObject.OutputArn),
+			names.AttrDescription:               aws.ToString(apiObject.Description),
+			names.AttrDestination:               aws.ToString(apiObject.Destination),
+			"encryption":                        flattenEncryption(apiObject.Encryption),
+			"listener_address":                  aws.ToString(apiObject.ListenerAddress),
+			"media_stream_output_configuration": flattenMediaStreamOutputConfigurations(apiObject.MediaStreamOutputConfigurations),
+			names.AttrName:                      aws.ToString(apiObject.Name),
+			"output_status":                     string(apiObject.OutputStatus),
+			names.AttrPort:                      aws.ToInt32(apiObject.Port),
+		}
+
+		if v := apiObject.Transport; v != nil {
+			tfMap["cidr_allow_list"] = flex.FlattenStringValueSet(v.CidrAllowList)
+			tfMap["max_latency"] = aws.ToInt32(v.MaxLatency)
+			tfMap["min_latency"] = aws.ToInt32(v.MinLatency)
+			tfMap[names.AttrProtocol] = string(v.Protocol)
+			tfMap["remote_id"] = aws.ToString(v.RemoteId)
+			tfMap["sender_control_port"] = aws.ToInt32(v.SenderControlPort)
+			tfMap["smoothing_latency"] = aws.ToInt32(v.SmoothingLatency)
+			tfMap["stream_id"] = aws.ToString(v.StreamId)
+		}
+
+		if v := apiObject.VpcInterfaceAttachment; v != nil {
+			tfMap["vpc_interface_name"] = aws.ToString(v.VpcInterfaceName)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMediaStreamOutputConfigurations(apiObjects []awstypes.MediaStreamOutputConfiguration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		var destinationConfigurations []interface{}
+
+		for _, apiObject := range apiObject.DestinationConfigurations {
+			tfMap := map[string]interface{}{
+				"destination_ip":   aws.ToString(apiObject.DestinationIp),
+				"destination_port": aws.ToInt32(apiObject.DestinationPort),
+				"outbound_ip":      aws.ToString(apiObject.OutboundIp),
+			}
+
+			if v := apiObject.Interface; v != nil {
+				tfMap["interface_name"] = aws.ToString(v.Name)
+			}
+
+			destinationConfigurations = append(destinationConfigurations, tfMap)
+		}
+
+		tfMap := map[string]interface{}{
+			"destination_configuration": destinationConfigurations,
+			"encoding_name":             string(apiObject.EncodingName),
+			"media_stream_name":         aws.ToString(apiObject.MediaStreamName),
+		}
+
+		if v := apiObject.EncodingParameters; v != nil {
+			tfMap["encoding_parameters"] = []interface{}{map[string]interface{}{
+				"compression_factor": aws.ToFloat64(v.CompressionFactor),
+				"encoder_profile":    string(v.EncoderProfile),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEntitlements(apiObjects []awstypes.Entitlement) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:                          aws.ToString(apiObject.EntitlementArn),
+			"data_transfer_subscriber_fee_percent": aws.ToInt32(apiObject.DataTransferSubscriberFeePercent),
+			names.AttrDescription:                  aws.ToString(apiObject.Description),
+			"encryption":                           flattenEncryption(apiObject.Encryption),
+			"entitlement_status":                   string(apiObject.EntitlementStatus),
+			names.AttrName:                         aws.ToString(apiObject.Name),
+			"subscribers":                          flex.FlattenStringValueSet(apiObject.Subscribers),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMediaStreams(apiObjects []awstypes.MediaStream) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"clock_rate":          aws.ToInt32(apiObject.ClockRate),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"fmt":                 aws.ToInt32(apiObject.Fmt),
+			"media_stream_id":     aws.ToInt32(apiObject.MediaStreamId),
+			"media_stream_name":   aws.ToString(apiObject.MediaStreamName),
+			"media_stream_type":   string(apiObject.MediaStreamType),
+			"video_format":        aws.ToString(apiObject.VideoFormat),
+		}
+
+		if v := apiObject.Attributes; v != nil {
+			attributes := map[string]interface{}{
+				"lang": aws.ToString(v.Lang),
+			}
+
+			if v := v.Fmtp; v != nil {
+				attributes["fmtp"] = []interface{}{map[string]interface{}{
+					"channel_order":   aws.ToString(v.ChannelOrder),
+					"colorimetry":     string(v.Colorimetry),
+					"exact_framerate": aws.ToString(v.ExactFramerate),
+					"par":             aws.ToString(v.Par),
+					"range":           string(v.Range),
+					"scan_mode":       string(v.ScanMode),
+					"tcs":             string(v.Tcs),
+				}}
+			}
+
+			tfMap["attributes"] = []interface{}{attributes}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenVPCInterfaces(apiObjects []awstypes.VpcInterface) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:             aws.ToString(apiObject.Name),
+			"network_interface_ids":    flex.FlattenStringValueList(apiObject.NetworkInterfaceIds),
+			"network_interface_type":   string(apiObject.NetworkInterfaceType),
+			names.AttrRoleARN:          aws.ToString(apiObject.RoleArn),
+			names.AttrSecurityGroupIDs: flex.FlattenStringValueSet(apiObject.SecurityGroupIds),
+			names.AttrSubnetID:         aws.ToString(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaConnectFlow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "mediaconnect", regexache.MustCompile(`flow:.+:`+rName)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrAvailabilityZone),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.ingest_ip"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.ingest_port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", "rtp"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "STANDBY"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediaconnect.ResourceFlow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccFlowConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_state(rName, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_state(rName, "STANDBY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "STANDBY"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_state(rName, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_outputsAndEntitlements(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, "10.0.0.1", "entitlement one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "entitlement one"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.subscribers.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.arn"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "10.0.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5010"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.protocol", "rtp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFlowConfig_outputsAndEntitlements(rName, "10.0.0.2", "entitlement two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "entitlement two"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output.0.destination", "10.0.0.2"),
+				),
+			},
+			{
+				Config: testAccFlowConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "output.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaConnectFlow_failover(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Flow
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mediaconnect_flow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlowConfig_failover(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFlowExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "source.1.name", "secondary"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.failover_mode", "FAILOVER"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.source_priority.0.primary_source", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "source_failover_config.0.state", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFlowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediaconnect_flow" {
+				continue
+			}
+
+			_, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaConnect Flow %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFlowExists(ctx context.Context, n string, v *awstypes.Flow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaConnectClient(ctx)
+
+		output, err := tfmediaconnect.FindFlowByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFlowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+}
+`, rName)
+}
+
+func testAccFlowConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFlowConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccFlowConfig_state(rName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name  = %[1]q
+  state = %[2]q
+
+  source {
+    name           = "source"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+}
+`, rName, state)
+}
+
+func testAccFlowConfig_outputsAndEntitlements(rName, destination, description string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "source"
+    protocol       = "rtp"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  output {
+    name        = "output"
+    protocol    = "rtp"
+    destination = %[2]q
+    port        = 5010
+  }
+
+  entitlement {
+    name        = "entitlement"
+    description = %[3]q
+    subscribers = [data.aws_caller_identity.current.account_id]
+  }
+}
+`, rName, destination, description)
+}
+
+func testAccFlowConfig_failover(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    name           = "primary"
+    protocol       = "rtp-fec"
+    ingest_port    = 5000
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  source {
+    name           = "secondary"
+    protocol       = "rtp-fec"
+    ingest_port    = 5002
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  source_failover_config {
+    failover_mode = "FAILOVER"
+    state         = "ENABLED"
+
+    source_priority {
+      primary_source = "primary"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediaconnect
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediaconnect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediaconnect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_mediaconnect_gateway", name="Gateway")
+func resourceGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGatewayCreate,
+		ReadWithoutTimeout:   resourceGatewayRead,
+		DeleteWithoutTimeout: resourceGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"egress_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"gateway_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCIDRBlock: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &mediaconnect.CreateGatewayInput{
+		EgressCidrBlocks: flex.ExpandStringValueSet(d.Get("egress_cidr_blocks").(*schema.Set)),
+		Name:             aws.String(name),
+		Networks:         expandGatewayNetworks(d.Get("network").([]interface{})),
+	}
+
+	output, err := conn.CreateGateway(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaConnect Gateway (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Gateway.GatewayArn))
+
+	if _, err := waitGatewayCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Gateway (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	gateway, err := findGatewayByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaConnect Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaConnect Gateway (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, gateway.GatewayArn)
+	d.Set("egress_cidr_blocks", gateway.EgressCidrBlocks)
+	d.Set("gateway_state", gateway.GatewayState)
+	d.Set(names.AttrName, gateway.Name)
+	if err := d.Set("network", flattenGatewayNetworks(gateway.Networks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting network: %s", err)
+	}
+
+	return diags
+}
+
+func resourceGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaConnectClient(ctx)
+
+	log.Printf("[DEBUG] Deleting MediaConnect Gateway: %s", d.Id())
+	_, err := conn.DeleteGateway(ctx, &mediaconnect.DeleteGatewayInput{
+		GatewayArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaConnect Gateway (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitGatewayDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MediaConnect Gateway (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findGatewayByARN(ctx context.Context, conn *mediaconnect.Client, arn string) (*awstypes.Gateway, error) {
+	input := &mediaconnect.DescribeGatewayInput{
+		GatewayArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeGateway(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Gateway == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if state := output.Gateway.GatewayState; state == awstypes.GatewayStateDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output.Gateway, nil
+}
+
+func statusGateway(ctx context.Context, conn *mediaconnect.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGatewayByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.GatewayState), nil
+	}
+}
+
+func waitGatewayCreated(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Gateway, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStateCreating),
+		Target:  enum.Slice(awstypes.GatewayStateActive),
+		Refresh: statusGateway(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Gateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGatewayDeleted(ctx context.Context, conn *mediaconnect.Client, arn string, timeout time.Duration) (*awstypes.Gateway, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GatewayStateActive, awstypes.GatewayStateDeleting),
+		Target:  []string{},
+		Refresh: statusGateway(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Gateway); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandGatewayNetworks(tfList []interface{}) []awstypes.GatewayNetwork {
+	var apiObjects []awstypes.GatewayNetwork
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.GatewayNetwork{
+			CidrBlock: aws.String(tfMap[names.AttrCIDRBlock].(string)),
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenGatewayNetworks(apiObjects []awstypes.GatewayNetwork) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrCIDRBlock: aws.ToString(apiObject.CidrBlock),
+			names.AttrName:      aws.ToString(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
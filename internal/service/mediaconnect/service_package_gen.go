@@ -27,7 +27,26 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceBridge,
+			TypeName: "aws_mediaconnect_bridge",
+			Name:     "Bridge",
+		},
+		{
+			Factory:  resourceFlow,
+			TypeName: "aws_mediaconnect_flow",
+			Name:     "Flow",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory:  resourceGateway,
+			TypeName: "aws_mediaconnect_gateway",
+			Name:     "Gateway",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
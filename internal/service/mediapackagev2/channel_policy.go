@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Channel Policy")
+func newChannelPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &channelPolicyResource{}, nil
+}
+
+type channelPolicyResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*channelPolicyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_mediapackagev2_channel_policy"
+}
+
+func (r *channelPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_group_name": nameAttribute(),
+			"channel_name":       nameAttribute(),
+			names.AttrID:         framework.IDAttribute(),
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *channelPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data channelPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	data.setID()
+	input := &mediapackagev2.PutChannelPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutChannelPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MediaPackage v2 Channel Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data channelPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	output, err := findChannelPolicyByTwoPartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading MediaPackage v2 Channel Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data channelPolicyResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	input := &mediapackagev2.PutChannelPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutChannelPolicy(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating MediaPackage v2 Channel Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data channelPolicyResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MediaPackageV2Client(ctx)
+
+	_, err := conn.DeleteChannelPolicy(ctx, &mediapackagev2.DeleteChannelPolicyInput{
+		ChannelGroupName: aws.String(data.ChannelGroupName.ValueString()),
+		ChannelName:      aws.String(data.ChannelName.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting MediaPackage v2 Channel Policy (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findChannelPolicyByTwoPartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName string) (*mediapackagev2.GetChannelPolicyOutput, error) {
+	input := &mediapackagev2.GetChannelPolicyInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+	}
+
+	output, err := conn.GetChannelPolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type channelPolicyResourceModel struct {
+	ChannelGroupName types.String      `tfsdk:"channel_group_name"`
+	ChannelName      types.String      `tfsdk:"channel_name"`
+	ID               types.String      `tfsdk:"id"`
+	Policy           fwtypes.IAMPolicy `tfsdk:"policy"`
+}
+
+const (
+	channelPolicyResourceIDPartCount = 2
+)
+
+func (model *channelPolicyResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(model.ID.ValueString(), channelPolicyResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	model.ChannelGroupName = types.StringValue(parts[0])
+	model.ChannelName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (model *channelPolicyResourceModel) setID() {
+	model.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{model.ChannelGroupName.ValueString(), model.ChannelName.ValueString()}, channelPolicyResourceIDPartCount, false)))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2Channel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "mediapackagev2", fmt.Sprintf("channelGroup/%[1]s/channel/%[1]s", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "ingest_endpoints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", "HLS"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2Channel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceChannel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2Channel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_full(rName, "description 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", "CMAF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfig_full(rName, "description 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", "CMAF"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_channel" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Channel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		_, err := tfmediapackagev2.FindChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccChannelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_channel" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  name               = %[1]q
+}
+`, rName))
+}
+
+func testAccChannelConfig_full(rName, description string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_channel" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  name               = %[1]q
+  description        = %[2]q
+  input_type         = "CMAF"
+}
+`, rName, description))
+}
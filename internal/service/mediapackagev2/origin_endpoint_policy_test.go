@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2OriginEndpointPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_origin_endpoint_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_mediapackagev2_channel_group.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_mediapackagev2_channel.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "origin_endpoint_name", "aws_mediapackagev2_origin_endpoint.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpointPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_origin_endpoint_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointPolicyConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointPolicyExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpointPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_origin_endpoint_policy" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointPolicyByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes["origin_endpoint_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Origin Endpoint Policy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointPolicyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		_, err := tfmediapackagev2.FindOriginEndpointPolicyByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes["origin_endpoint_name"])
+
+		return err
+	}
+}
+
+func testAccOriginEndpointPolicyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_basic(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediapackagev2_origin_endpoint_policy" "test" {
+  channel_group_name   = aws_mediapackagev2_channel_group.test.name
+  channel_name         = aws_mediapackagev2_channel.test.name
+  origin_endpoint_name = aws_mediapackagev2_origin_endpoint.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowGet"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "mediapackagev2:GetObject"
+      Resource = aws_mediapackagev2_origin_endpoint.test.arn
+    }]
+  })
+}
+
+data "aws_partition" "current" {}
+`)
+}
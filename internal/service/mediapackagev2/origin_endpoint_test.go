@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "mediapackagev2", fmt.Sprintf("channelGroup/%[1]s/channel/%[1]s/originEndpoint/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "TS"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_cmaf(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_mediapackagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_cmaf(rName, 6, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "CMAF"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.manifest_name", "dash"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.segment_template_format", "NUMBER_WITH_TIMELINE"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.0.manifest_name", "llhls"),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "300"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_cmaf(rName, 4, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_mediapackagev2_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage v2 Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+		return err
+	}
+}
+
+func testAccOriginEndpointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_mediapackagev2_channel_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mediapackagev2_channel" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  name               = %[1]q
+}
+`, rName)
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name = aws_mediapackagev2_channel_group.test.name
+  channel_name       = aws_mediapackagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_cmaf(rName string, segmentDuration, startoverWindow int) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_mediapackagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_mediapackagev2_channel_group.test.name
+  channel_name             = aws_mediapackagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "CMAF"
+  startover_window_seconds = %[3]d
+
+  segment {
+    segment_duration_seconds = %[2]d
+    segment_name             = "segment"
+
+    scte {
+      scte_filter = ["SPLICE_INSERT"]
+    }
+  }
+
+  dash_manifest {
+    manifest_name           = "dash"
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+  }
+
+  low_latency_hls_manifest {
+    manifest_name = "llhls"
+  }
+}
+`, rName, segmentDuration, startoverWindow))
+}
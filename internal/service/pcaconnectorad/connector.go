@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_connector", name="Connector")
+// @Tags(identifierAttribute="arn")
+func newConnectorResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &connectorResource{}
+
+	r.SetDefaultCreateTimeout(15 * time.Minute)
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type connectorResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[connectorResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*connectorResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_connector"
+}
+
+func (r *connectorResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"certificate_authority_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_enrollment_policy_server_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directory_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			"vpc_information": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcInformationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 4),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *connectorResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data connectorResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	input := &pcaconnectorad.CreateConnectorInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConnector(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Private CA Connector for Active Directory Connector", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.ConnectorArn)
+	data.setID()
+
+	connector, err := waitConnectorCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Private CA Connector for Active Directory Connector (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.CertificateEnrollmentPolicyServerEndpoint = fwflex.StringToFramework(ctx, connector.CertificateEnrollmentPolicyServerEndpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *connectorResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data connectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	connector, err := findConnectorByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Connector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, connector, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *connectorResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data connectorResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteConnector(ctx, &pcaconnectorad.DeleteConnectorInput{
+		ConnectorArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Connector (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitConnectorDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Private CA Connector for Active Directory Connector (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *connectorResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findConnectorByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Connector, error) {
+	input := &pcaconnectorad.GetConnectorInput{
+		ConnectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetConnector(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Connector == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Connector, nil
+}
+
+func statusConnector(ctx context.Context, conn *pcaconnectorad.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findConnectorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitConnectorCreated(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.Connector, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ConnectorStatusCreating),
+		Target:  enum.Slice(awstypes.ConnectorStatusActive),
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Connector); ok {
+		tfresource.SetLastError(err, errors.New(string(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorDeleted(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.Connector, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ConnectorStatusActive, awstypes.ConnectorStatusDeleting),
+		Target:  []string{},
+		Refresh: statusConnector(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Connector); ok {
+		tfresource.SetLastError(err, errors.New(string(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type connectorResourceModel struct {
+	ARN                                       types.String                                         `tfsdk:"arn"`
+	CertificateAuthorityARN                   fwtypes.ARN                                          `tfsdk:"certificate_authority_arn"`
+	CertificateEnrollmentPolicyServerEndpoint types.String                                         `tfsdk:"certificate_enrollment_policy_server_endpoint"`
+	DirectoryID                               types.String                                         `tfsdk:"directory_id"`
+	ID                                        types.String                                         `tfsdk:"id"`
+	Tags                                      types.Map                                            `tfsdk:"tags"`
+	TagsAll                                   types.Map                                            `tfsdk:"tags_all"`
+	Timeouts                                  timeouts.Value                                       `tfsdk:"timeouts"`
+	VPCInformation                            fwtypes.ListNestedObjectValueOf[vpcInformationModel] `tfsdk:"vpc_information"`
+}
+
+func (data *connectorResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *connectorResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type vpcInformationModel struct {
+	SecurityGroupIDs fwtypes.SetValueOf[types.String] `tfsdk:"security_group_ids"`
+}
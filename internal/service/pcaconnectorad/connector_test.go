@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADConnector_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Connector
+	resourceName := "aws_pcaconnectorad_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckDirectoryService(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", "aws_acmpca_certificate_authority.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_enrollment_policy_server_endpoint"),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "vpc_information.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "vpc_information.0.security_group_ids.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADConnector_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Connector
+	resourceName := "aws_pcaconnectorad_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckDirectoryService(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceConnector, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADConnector_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Connector
+	resourceName := "aws_pcaconnectorad_connector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckDirectoryService(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_tags1(rName, domain, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccConnectorConfig_tags2(rName, domain, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccConnectorConfig_tags1(rName, domain, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_connector" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindConnectorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Connector %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConnectorExists(ctx context.Context, n string, v *awstypes.Connector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindConnectorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+	input := &pcaconnectorad.ListConnectorsInput{}
+
+	_, err := conn.ListConnectors(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccConnectorConfig_base(rName, domain string) string {
+	return acctest.ConfigCompose(testAccDirectoryRegistrationConfig_base(rName, domain), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[2]q
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  certificate               = aws_acmpca_certificate.test.certificate
+  certificate_chain         = aws_acmpca_certificate.test.certificate_chain
+}
+`, rName, domain))
+}
+
+func testAccConnectorConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName, domain), `
+resource "aws_pcaconnectorad_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  directory_id              = aws_directory_service_directory.test.id
+
+  vpc_information {
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.test]
+}
+`)
+}
+
+func testAccConnectorConfig_tags1(rName, domain, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  directory_id              = aws_directory_service_directory.test.id
+
+  vpc_information {
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccConnectorConfig_tags2(rName, domain, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_base(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_connector" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  directory_id              = aws_directory_service_directory.test.id
+
+  vpc_information {
+    security_group_ids = [aws_security_group.test.id]
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,1141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_template", name="Template")
+// @Tags(identifierAttribute="arn")
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateResource{}
+
+	r.SetDefaultDeleteTimeout(15 * time.Minute)
+
+	return r, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*templateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template"
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\x20-\x21\x24-\x26\x28-\x2A\x2D-\x3A\x3F-\x5B\x5D-\x7E]+$`), `must contain only printable ASCII characters other than \'+,;<=>#"`),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV2Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("template_v2"),
+									path.MatchRelative().AtParent().AtName("template_v3"),
+									path.MatchRelative().AtParent().AtName("template_v4"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": certificateValidityBlock(ctx),
+									"enrollment_flags":     enrollmentFlagsBlock(ctx),
+									"extensions":           extensionsBlock(ctx),
+									"general_flags":        generalFlagsBlock(ctx),
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"crypto_providers":   cryptoProvidersAttribute(),
+												"key_spec":           keySpecAttribute(),
+												"minimal_key_length": minimalKeyLengthAttribute(),
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV2Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV2](),
+													Required:   true,
+												},
+												"exportable_key":                 optionalComputedBoolAttribute(),
+												"strong_key_protection_required": optionalComputedBoolAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": subjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v3": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV3Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Required:   true,
+									},
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": certificateValidityBlock(ctx),
+									"enrollment_flags":     enrollmentFlagsBlock(ctx),
+									"extensions":           extensionsBlock(ctx),
+									"general_flags":        generalFlagsBlock(ctx),
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"algorithm": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+													Required:   true,
+												},
+												"crypto_providers":   cryptoProvidersAttribute(),
+												"key_spec":           keySpecAttribute(),
+												"minimal_key_length": minimalKeyLengthAttribute(),
+											},
+											Blocks: map[string]schema.Block{
+												"key_usage_property": keyUsagePropertyBlock(ctx, true),
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV3](),
+													Required:   true,
+												},
+												"exportable_key":                        optionalComputedBoolAttribute(),
+												"require_alternate_signature_algorithm": optionalComputedBoolAttribute(),
+												"strong_key_protection_required":        optionalComputedBoolAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": subjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+						"template_v4": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateV4Model](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"hash_algorithm": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+										Optional:   true,
+									},
+									"superseded_templates": supersededTemplatesAttribute(),
+								},
+								Blocks: map[string]schema.Block{
+									"certificate_validity": certificateValidityBlock(ctx),
+									"enrollment_flags":     enrollmentFlagsBlock(ctx),
+									"extensions":           extensionsBlock(ctx),
+									"general_flags":        generalFlagsBlock(ctx),
+									"private_key_attributes": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"algorithm": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+													Optional:   true,
+												},
+												"crypto_providers":   cryptoProvidersAttribute(),
+												"key_spec":           keySpecAttribute(),
+												"minimal_key_length": minimalKeyLengthAttribute(),
+											},
+											Blocks: map[string]schema.Block{
+												"key_usage_property": keyUsagePropertyBlock(ctx, false),
+											},
+										},
+									},
+									"private_key_flags": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyFlagsV4Model](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"client_version": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ClientCompatibilityV4](),
+													Required:   true,
+												},
+												"exportable_key":                        optionalComputedBoolAttribute(),
+												"require_alternate_signature_algorithm": optionalComputedBoolAttribute(),
+												"require_same_key_renewal":              optionalComputedBoolAttribute(),
+												"strong_key_protection_required":        optionalComputedBoolAttribute(),
+												"use_legacy_provider":                   optionalComputedBoolAttribute(),
+											},
+										},
+									},
+									"subject_name_flags": subjectNameFlagsBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func optionalComputedBoolAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func supersededTemplatesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(100),
+		},
+	}
+}
+
+func cryptoProvidersAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(100),
+		},
+	}
+}
+
+func keySpecAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+		Required:   true,
+	}
+}
+
+func minimalKeyLengthAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func certificateValidityBlock(ctx context.Context) schema.ListNestedBlock {
+	validityPeriodBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"period": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 8766000),
+					},
+				},
+				"period_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+					Required:   true,
+				},
+			},
+		},
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"renewal_period":  validityPeriodBlock,
+				"validity_period": validityPeriodBlock,
+			},
+		},
+	}
+}
+
+func enrollmentFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[enrollmentFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"enable_key_reuse_on_nt_token_keyset_storage_full": optionalComputedBoolAttribute(),
+				"include_symmetric_algorithms":                     optionalComputedBoolAttribute(),
+				"no_security_extension":                            optionalComputedBoolAttribute(),
+				"remove_invalid_certificate_from_personal_store":   optionalComputedBoolAttribute(),
+				"user_interaction_required":                        optionalComputedBoolAttribute(),
+			},
+		},
+	}
+}
+
+func extensionsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"application_policies": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"critical": optionalComputedBoolAttribute(),
+						},
+						Blocks: map[string]schema.Block{
+							"policy": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeBetween(1, 100),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"policy_object_identifier": schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthBetween(1, 64),
+												stringvalidator.RegexMatches(regexache.MustCompile(`^([0-2])\.([0-9]|([0-3][0-9]))(\.([0-9]+)){0,126}$`), "must be a valid object identifier (OID)"),
+												stringvalidator.ExactlyOneOf(
+													path.MatchRelative().AtParent().AtName("policy_object_identifier"),
+													path.MatchRelative().AtParent().AtName("policy_type"),
+												),
+											},
+										},
+										"policy_type": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+											Optional:   true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"key_usage": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"critical": optionalComputedBoolAttribute(),
+						},
+						Blocks: map[string]schema.Block{
+							"usage_flags": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageFlagsModel](ctx),
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"data_encipherment": optionalComputedBoolAttribute(),
+										"digital_signature": optionalComputedBoolAttribute(),
+										"key_agreement":     optionalComputedBoolAttribute(),
+										"key_encipherment":  optionalComputedBoolAttribute(),
+										"non_repudiation":   optionalComputedBoolAttribute(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func generalFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[generalFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"auto_enrollment": optionalComputedBoolAttribute(),
+				"machine_type":    optionalComputedBoolAttribute(),
+			},
+		},
+	}
+}
+
+func keyUsagePropertyBlock(ctx context.Context, required bool) schema.ListNestedBlock {
+	validators := []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+	if required {
+		validators = append(validators, listvalidator.IsRequired())
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"property_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+					Optional:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"property_flags": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyFlagsModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+						listvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("property_flags"),
+							path.MatchRelative().AtParent().AtName("property_type"),
+						),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"decrypt":       optionalComputedBoolAttribute(),
+							"key_agreement": optionalComputedBoolAttribute(),
+							"sign":          optionalComputedBoolAttribute(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func subjectNameFlagsBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[subjectNameFlagsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"require_common_name":        optionalComputedBoolAttribute(),
+				"require_directory_path":     optionalComputedBoolAttribute(),
+				"require_dns_as_cn":          optionalComputedBoolAttribute(),
+				"require_email":              optionalComputedBoolAttribute(),
+				"san_require_directory_guid": optionalComputedBoolAttribute(),
+				"san_require_dns":            optionalComputedBoolAttribute(),
+				"san_require_domain_dns":     optionalComputedBoolAttribute(),
+				"san_require_email":          optionalComputedBoolAttribute(),
+				"san_require_spn":            optionalComputedBoolAttribute(),
+				"san_require_upn":            optionalComputedBoolAttribute(),
+			},
+		},
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &pcaconnectorad.CreateTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Private CA Connector for Active Directory Template (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.setID()
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ObjectIdentifier = fwflex.StringToFramework(ctx, template.ObjectIdentifier)
+	data.PolicySchema = fwflex.Int32ToFramework(ctx, template.PolicySchema)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	template, err := findTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, template, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.Definition.Equal(old.Definition) {
+		input := &pcaconnectorad.UpdateTemplateInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.TemplateArn = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Private CA Connector for Active Directory Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	_, err := conn.DeleteTemplate(ctx, &pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Private CA Connector for Active Directory Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitTemplateDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Private CA Connector for Active Directory Template (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *templateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := &pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
+
+func statusTemplate(ctx context.Context, conn *pcaconnectorad.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTemplateByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTemplateDeleted(ctx context.Context, conn *pcaconnectorad.Client, arn string, timeout time.Duration) (*awstypes.Template, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.TemplateStatusActive, awstypes.TemplateStatusDeleting),
+		Target:  []string{},
+		Refresh: statusTemplate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Template); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type templateResourceModel struct {
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	ID                            types.String                                             `tfsdk:"id"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int64                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          types.Map                                                `tfsdk:"tags"`
+	TagsAll                       types.Map                                                `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                           `tfsdk:"timeouts"`
+}
+
+func (data *templateResourceModel) InitFromID() error {
+	data.ARN = data.ID
+
+	return nil
+}
+
+func (data *templateResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+var (
+	_ fwflex.Expander  = templateDefinitionModel{}
+	_ fwflex.Flattener = &templateDefinitionModel{}
+)
+
+func (m templateDefinitionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.TemplateV2.IsNull():
+		templateV2Data, d := m.TemplateV2.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TemplateDefinitionMemberTemplateV2
+		diags.Append(fwflex.Expand(ctx, templateV2Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.TemplateV3.IsNull():
+		templateV3Data, d := m.TemplateV3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TemplateDefinitionMemberTemplateV3
+		diags.Append(fwflex.Expand(ctx, templateV3Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.TemplateV4.IsNull():
+		templateV4Data, d := m.TemplateV4.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.TemplateDefinitionMemberTemplateV4
+		diags.Append(fwflex.Expand(ctx, templateV4Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *templateDefinitionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.TemplateV2 = fwtypes.NewListNestedObjectValueOfNull[templateV2Model](ctx)
+	m.TemplateV3 = fwtypes.NewListNestedObjectValueOfNull[templateV3Model](ctx)
+	m.TemplateV4 = fwtypes.NewListNestedObjectValueOfNull[templateV4Model](ctx)
+
+	switch t := v.(type) {
+	case awstypes.TemplateDefinitionMemberTemplateV2:
+		var model templateV2Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.TemplateDefinitionMemberTemplateV3:
+		var model templateV3Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.TemplateDefinitionMemberTemplateV4:
+		var model templateV4Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV4 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListValueOf[types.String]                            `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListValueOf[types.String]                          `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListValueOf[types.String]                          `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+var (
+	_ fwflex.Expander  = applicationPolicyModel{}
+	_ fwflex.Flattener = &applicationPolicyModel{}
+)
+
+func (m applicationPolicyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.PolicyObjectIdentifier.IsNull():
+		return &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+			Value: m.PolicyObjectIdentifier.ValueString(),
+		}, diags
+
+	case !m.PolicyType.IsNull():
+		return &awstypes.ApplicationPolicyMemberPolicyType{
+			Value: m.PolicyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *applicationPolicyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.PolicyObjectIdentifier = types.StringNull()
+	m.PolicyType = fwtypes.StringEnumNull[awstypes.ApplicationPolicyType]()
+
+	switch t := v.(type) {
+	case awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+		m.PolicyObjectIdentifier = types.StringValue(t.Value)
+
+	case awstypes.ApplicationPolicyMemberPolicyType:
+		m.PolicyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.ListValueOf[types.String]    `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int64                          `tfsdk:"minimal_key_length"`
+}
+
+type privateKeyAttributesModel struct {
+	Algorithm        fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm]       `tfsdk:"algorithm"`
+	CryptoProviders  fwtypes.ListValueOf[types.String]                      `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec]                   `tfsdk:"key_spec"`
+	KeyUsageProperty fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int64                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+var (
+	_ fwflex.Expander  = keyUsagePropertyModel{}
+	_ fwflex.Flattener = &keyUsagePropertyModel{}
+)
+
+func (m keyUsagePropertyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.PropertyFlags.IsNull():
+		propertyFlagsData, d := m.PropertyFlags.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.KeyUsagePropertyMemberPropertyFlags
+		diags.Append(fwflex.Expand(ctx, propertyFlagsData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.PropertyType.IsNull():
+		return &awstypes.KeyUsagePropertyMemberPropertyType{
+			Value: m.PropertyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *keyUsagePropertyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	m.PropertyFlags = fwtypes.NewListNestedObjectValueOfNull[keyUsagePropertyFlagsModel](ctx)
+	m.PropertyType = fwtypes.StringEnumNull[awstypes.KeyUsagePropertyType]()
+
+	switch t := v.(type) {
+	case awstypes.KeyUsagePropertyMemberPropertyFlags:
+		var model keyUsagePropertyFlagsModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.PropertyFlags = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.KeyUsagePropertyMemberPropertyType:
+		m.PropertyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SANRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SANRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SANRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SANRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SANRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SANRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlEntry
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckDirectoryService(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "ALLOW", "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.auto_enroll", "DENY"),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.enroll", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "group_display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "group_security_identifier", "S-1-5-21-1004336348-1177238915-682003330-512"),
+					resource.TestCheckResourceAttrPair(resourceName, "template_arn", "aws_pcaconnectorad_template.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "ALLOW", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.auto_enroll", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "access_rights.0.enroll", "ALLOW"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.AccessControlEntry
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckDirectoryService(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "ALLOW", "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceTemplateGroupAccessControlEntry, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateGroupAccessControlEntryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template_group_access_control_entry" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Private CA Connector for Active Directory Template Group Access Control Entry %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateGroupAccessControlEntryExists(ctx context.Context, n string, v *awstypes.AccessControlEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, enroll, autoEnroll string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template_group_access_control_entry" "test" {
+  group_display_name        = %[1]q
+  group_security_identifier = "S-1-5-21-1004336348-1177238915-682003330-512"
+  template_arn              = aws_pcaconnectorad_template.test.arn
+
+  access_rights {
+    auto_enroll = %[3]q
+    enroll      = %[2]q
+  }
+}
+`, rName, enroll, autoEnroll))
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rds_cluster_snapshot_copy", name="DB Cluster Snapshot")
+// @Tags(identifierAttribute="db_cluster_snapshot_arn")
+// @Testing(tagsTest=false)
+func resourceClusterSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterSnapshotCopyCreate,
+		ReadWithoutTimeout:   resourceClusterSnapshotCopyRead,
+		UpdateWithoutTimeout: resourceClusterSnapshotCopyUpdate,
+		DeleteWithoutTimeout: resourceClusterSnapshotCopyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAllocatedStorage: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrEngine: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrEngineVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"presigned_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrStorageEncrypted: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrStorageType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"target_db_cluster_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]+$`), "must contain only alphanumeric characters and hyphens"),
+					validation.StringMatch(regexache.MustCompile(`^[A-Za-z]`), "must begin with a letter"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`-$`), "cannot end with a hyphen"),
+				),
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceClusterSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	targetDBClusterSnapshotID := d.Get("target_db_cluster_snapshot_identifier").(string)
+	input := &rds.CopyDBClusterSnapshotInput{
+		SourceDBClusterSnapshotIdentifier: aws.String(d.Get("source_db_cluster_snapshot_identifier").(string)),
+		Tags:                              getTagsIn(ctx),
+		TargetDBClusterSnapshotIdentifier: aws.String(targetDBClusterSnapshotID),
+	}
+
+	if v, ok := d.GetOk("copy_tags"); ok {
+		input.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("destination_region"); ok {
+		input.DestinationRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("presigned_url"); ok {
+		input.PreSignedUrl = aws.String(v.(string))
+	}
+
+	// When a source Region is set and no pre-signed URL is supplied, the AWS SDK generates the pre-signed URL.
+	if v, ok := d.GetOk("source_region"); ok {
+		input.SourceRegion = aws.String(v.(string))
+	}
+
+	output, err := conn.CopyDBClusterSnapshotWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS DB Cluster Snapshot Copy (%s): %s", targetDBClusterSnapshotID, err)
+	}
+
+	d.SetId(aws.StringValue(output.DBClusterSnapshot.DBClusterSnapshotIdentifier))
+
+	if _, err := waitDBClusterSnapshotCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Cluster Snapshot Copy (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterSnapshotCopyRead(ctx, d, meta)...)
+}
+
+func resourceClusterSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	snapshot, err := FindDBClusterSnapshotByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Cluster Snapshot Copy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Cluster Snapshot Copy (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrAllocatedStorage, snapshot.AllocatedStorage)
+	d.Set("db_cluster_snapshot_arn", snapshot.DBClusterSnapshotArn)
+	d.Set(names.AttrEngine, snapshot.Engine)
+	d.Set(names.AttrEngineVersion, snapshot.EngineVersion)
+	d.Set(names.AttrKMSKeyID, snapshot.KmsKeyId)
+	d.Set("license_model", snapshot.LicenseModel)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("source_db_cluster_snapshot_arn", snapshot.SourceDBClusterSnapshotArn)
+	d.Set(names.AttrStorageEncrypted, snapshot.StorageEncrypted)
+	d.Set(names.AttrStorageType, snapshot.StorageType)
+	d.Set("target_db_cluster_snapshot_identifier", snapshot.DBClusterSnapshotIdentifier)
+	d.Set(names.AttrVPCID, snapshot.VpcId)
+
+	setTagsOut(ctx, snapshot.TagList)
+
+	return diags
+}
+
+func resourceClusterSnapshotCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceClusterSnapshotCopyRead(ctx, d, meta)
+}
+
+func resourceClusterSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	log.Printf("[DEBUG] Deleting RDS DB Cluster Snapshot Copy: %s", d.Id())
+	_, err := conn.DeleteDBClusterSnapshotWithContext(ctx, &rds.DeleteDBClusterSnapshotInput{
+		DBClusterSnapshotIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterSnapshotNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS DB Cluster Snapshot Copy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
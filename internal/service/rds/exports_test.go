@@ -7,7 +7,9 @@ package rds
 var (
 	ResourceCertificate             = resourceCertificate
 	ResourceCluster                 = resourceCluster
+	ResourceClusterSnapshotCopy     = resourceClusterSnapshotCopy
 	ResourceEventSubscription       = resourceEventSubscription
+	ResourceIntegration             = newIntegrationResource
 	ResourceProxy                   = resourceProxy
 	ResourceProxyDefaultTargetGroup = resourceProxyDefaultTargetGroup
 	ResourceProxyEndpoint           = resourceProxyEndpoint
@@ -22,6 +24,7 @@ var (
 	FindDefaultCertificate                     = findDefaultCertificate
 	FindDefaultDBProxyTargetGroupByDBProxyName = findDefaultDBProxyTargetGroupByDBProxyName
 	FindEventSubscriptionByID                  = findEventSubscriptionByID
+	FindIntegrationByARN                       = findIntegrationByARN
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParseDBInstanceARN                         = parseDBInstanceARN
@@ -0,0 +1,432 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rds_integration", name="Integration")
+// @Tags(identifierAttribute="arn")
+func newIntegrationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &integrationResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type integrationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*integrationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rds_integration"
+}
+
+func (r *integrationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"data_filter": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"integration_name": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTargetARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *integrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	name := data.IntegrationName.ValueString()
+	input := &rds.CreateIntegrationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsInV2(ctx)
+
+	output, err := conn.CreateIntegration(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Integration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.IntegrationArn)
+
+	integration, err := waitIntegrationCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Integration (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
+	data.IntegrationARN = fwflex.StringToFramework(ctx, integration.IntegrationArn)
+	data.KMSKeyID = fwflex.StringToFramework(ctx, integration.KMSKeyId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *integrationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	output, err := findIntegrationByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty encryption context is returned if none was specified.
+	if len(output.AdditionalEncryptionContext) == 0 {
+		data.AdditionalEncryptionContext = fwtypes.NewMapValueOfNull[types.String](ctx)
+	}
+
+	setTagsOutV2(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *integrationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new integrationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.DataFilter.Equal(old.DataFilter) ||
+		!new.Description.Equal(old.Description) ||
+		!new.IntegrationName.Equal(old.IntegrationName) {
+		input := &rds.ModifyIntegrationInput{
+			IntegrationIdentifier: aws.String(new.ID.ValueString()),
+		}
+
+		if !new.DataFilter.Equal(old.DataFilter) {
+			input.DataFilter = fwflex.StringFromFramework(ctx, new.DataFilter)
+		}
+
+		if !new.Description.Equal(old.Description) {
+			// An empty description removes any existing value.
+			input.Description = aws.String(new.Description.ValueString())
+		}
+
+		if !new.IntegrationName.Equal(old.IntegrationName) {
+			input.IntegrationName = fwflex.StringFromFramework(ctx, new.IntegrationName)
+		}
+
+		_, err := conn.ModifyIntegration(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Integration (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		integration, err := waitIntegrationUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Integration (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DataFilter = fwflex.StringToFramework(ctx, integration.DataFilter)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *integrationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data integrationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	_, err := conn.DeleteIntegration(ctx, &rds.DeleteIntegrationInput{
+		IntegrationIdentifier: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.IntegrationNotFoundFault](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting RDS Integration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitIntegrationDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Integration (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *integrationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIntegrationByARN(ctx context.Context, conn *rds.Client, arn string) (*awstypes.Integration, error) {
+	input := &rds.DescribeIntegrationsInput{
+		IntegrationIdentifier: aws.String(arn),
+	}
+
+	return findIntegration(ctx, conn, input, tfslices.PredicateTrue[*awstypes.Integration]())
+}
+
+func findIntegration(ctx context.Context, conn *rds.Client, input *rds.DescribeIntegrationsInput, filter tfslices.Predicate[*awstypes.Integration]) (*awstypes.Integration, error) {
+	output, err := findIntegrations(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIntegrations(ctx context.Context, conn *rds.Client, input *rds.DescribeIntegrationsInput, filter tfslices.Predicate[*awstypes.Integration]) ([]awstypes.Integration, error) {
+	var output []awstypes.Integration
+
+	pages := rds.NewDescribeIntegrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.IntegrationNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Integrations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func statusIntegration(ctx context.Context, conn *rds.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIntegrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIntegrationCreated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IntegrationStatusCreating, awstypes.IntegrationStatusModifying),
+		Target:  enum.Slice(awstypes.IntegrationStatusActive),
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationUpdated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.IntegrationStatusModifying, awstypes.IntegrationStatusSyncing),
+		Target:                    enum.Slice(awstypes.IntegrationStatusActive),
+		Refresh:                   statusIntegration(ctx, conn, arn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIntegrationDeleted(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.Integration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.IntegrationStatusActive, awstypes.IntegrationStatusDeleting),
+		Target:  []string{},
+		Refresh: statusIntegration(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Integration); ok {
+		tfresource.SetLastError(err, integrationError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func integrationError(v *awstypes.Integration) error {
+	return errors.Join(tfslices.ApplyToAll(v.Errors, func(v awstypes.IntegrationError) error {
+		return fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+	})...)
+}
+
+type integrationResourceModel struct {
+	AdditionalEncryptionContext fwtypes.MapValueOf[types.String] `tfsdk:"additional_encryption_context"`
+	DataFilter                  types.String                     `tfsdk:"data_filter"`
+	Description                 types.String                     `tfsdk:"description"`
+	ID                          types.String                     `tfsdk:"id"`
+	IntegrationARN              types.String                     `tfsdk:"arn"`
+	IntegrationName             types.String                     `tfsdk:"integration_name"`
+	KMSKeyID                    types.String                     `tfsdk:"kms_key_id"`
+	SourceARN                   fwtypes.ARN                      `tfsdk:"source_arn"`
+	Tags                        types.Map                        `tfsdk:"tags"`
+	TagsAll                     types.Map                        `tfsdk:"tags_all"`
+	TargetARN                   fwtypes.ARN                      `tfsdk:"target_arn"`
+	Timeouts                    timeouts.Value                   `tfsdk:"timeouts"`
+}
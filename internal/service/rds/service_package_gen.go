@@ -24,6 +24,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newIntegrationResource,
+			Name:    "Integration",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory: newResourceExportTask,
 		},
@@ -244,6 +251,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClusterRoleAssociation,
 			TypeName: "aws_rds_cluster_role_association",
 		},
+		{
+			Factory:  resourceClusterSnapshotCopy,
+			TypeName: "aws_rds_cluster_snapshot_copy",
+			Name:     "DB Cluster Snapshot",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "db_cluster_snapshot_arn",
+			},
+		},
 		{
 			Factory:  ResourceCustomDBEngineVersion,
 			TypeName: "aws_rds_custom_db_engine_version",
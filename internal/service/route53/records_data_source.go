@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_records", name="Records")
+func newRecordsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &recordsDataSource{}, nil
+}
+
+type recordsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*recordsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_route53_records"
+}
+
+func (d *recordsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			"resource_record_sets": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceRecordSetModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[resourceRecordSetModel](ctx),
+				},
+			},
+			"set_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+				Optional:   true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *recordsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data recordsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	filter := tfslices.PredicateTrue[*awstypes.ResourceRecordSet]()
+	if !data.NameRegex.IsNull() {
+		filter = tfslices.PredicateAnd(filter, func(v *awstypes.ResourceRecordSet) bool {
+			return data.NameRegex.ValueRegexp().MatchString(normalizeRecordSetName(aws.ToString(v.Name)))
+		})
+	}
+	if !data.SetIdentifier.IsNull() {
+		filter = tfslices.PredicateAnd(filter, func(v *awstypes.ResourceRecordSet) bool {
+			return aws.ToString(v.SetIdentifier) == data.SetIdentifier.ValueString()
+		})
+	}
+	if !data.Type.IsNull() {
+		filter = tfslices.PredicateAnd(filter, func(v *awstypes.ResourceRecordSet) bool {
+			return v.Type == data.Type.ValueEnum()
+		})
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), filter)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Route 53 Records", err.Error())
+
+		return
+	}
+
+	for i, v := range output {
+		output[i].Name = aws.String(normalizeRecordSetName(aws.ToString(v.Name)))
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.ResourceRecordSets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, zoneID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// normalizeRecordSetName removes any escape sequences and the trailing period
+// from a record name returned by the Route 53 API.
+func normalizeRecordSetName(name string) string {
+	return strings.TrimSuffix(cleanRecordName(name), ".")
+}
+
+type recordsDataSourceModel struct {
+	ID                 types.String                                            `tfsdk:"id"`
+	NameRegex          fwtypes.Regexp                                          `tfsdk:"name_regex"`
+	ResourceRecordSets fwtypes.ListNestedObjectValueOf[resourceRecordSetModel] `tfsdk:"resource_record_sets"`
+	SetIdentifier      types.String                                            `tfsdk:"set_identifier"`
+	Type               fwtypes.StringEnum[awstypes.RRType]                     `tfsdk:"type"`
+	ZoneID             types.String                                            `tfsdk:"zone_id"`
+}
+
+type resourceRecordSetModel struct {
+	AliasTarget             fwtypes.ListNestedObjectValueOf[aliasTargetModel]          `tfsdk:"alias_target"`
+	CIDRRoutingConfig       fwtypes.ListNestedObjectValueOf[cidrRoutingConfigModel]    `tfsdk:"cidr_routing_config"`
+	Failover                fwtypes.StringEnum[awstypes.ResourceRecordSetFailover]     `tfsdk:"failover"`
+	GeoLocation             fwtypes.ListNestedObjectValueOf[geoLocationModel]          `tfsdk:"geolocation"`
+	GeoProximityLocation    fwtypes.ListNestedObjectValueOf[geoProximityLocationModel] `tfsdk:"geoproximity_location"`
+	HealthCheckID           types.String                                               `tfsdk:"health_check_id"`
+	MultiValueAnswer        types.Bool                                                 `tfsdk:"multi_value_answer"`
+	Name                    types.String                                               `tfsdk:"name"`
+	Region                  fwtypes.StringEnum[awstypes.ResourceRecordSetRegion]       `tfsdk:"region"`
+	ResourceRecords         fwtypes.ListNestedObjectValueOf[resourceRecordModel]       `tfsdk:"resource_records"`
+	SetIdentifier           types.String                                               `tfsdk:"set_identifier"`
+	TrafficPolicyInstanceID types.String                                               `tfsdk:"traffic_policy_instance_id"`
+	TTL                     types.Int64                                                `tfsdk:"ttl"`
+	Type                    fwtypes.StringEnum[awstypes.RRType]                        `tfsdk:"type"`
+	Weight                  types.Int64                                                `tfsdk:"weight"`
+}
+
+type aliasTargetModel struct {
+	DNSName              types.String `tfsdk:"dns_name"`
+	EvaluateTargetHealth types.Bool   `tfsdk:"evaluate_target_health"`
+	HostedZoneID         types.String `tfsdk:"hosted_zone_id"`
+}
+
+type cidrRoutingConfigModel struct {
+	CollectionID types.String `tfsdk:"collection_id"`
+	LocationName types.String `tfsdk:"location_name"`
+}
+
+type geoLocationModel struct {
+	ContinentCode   types.String `tfsdk:"continent_code"`
+	CountryCode     types.String `tfsdk:"country_code"`
+	SubdivisionCode types.String `tfsdk:"subdivision_code"`
+}
+
+type geoProximityLocationModel struct {
+	AWSRegion      types.String                                      `tfsdk:"aws_region"`
+	Bias           types.Int64                                       `tfsdk:"bias"`
+	Coordinates    fwtypes.ListNestedObjectValueOf[coordinatesModel] `tfsdk:"coordinates"`
+	LocalZoneGroup types.String                                      `tfsdk:"local_zone_group"`
+}
+
+type coordinatesModel struct {
+	Latitude  types.String `tfsdk:"latitude"`
+	Longitude types.String `tfsdk:"longitude"`
+}
+
+type resourceRecordModel struct {
+	Value types.String `tfsdk:"value"`
+}
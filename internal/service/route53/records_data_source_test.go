@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, "aws_route53_zone.test", "zone_id"),
+					// NS, SOA, 1 simple, 2 weighted and 1 alias record.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "6"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						names.AttrName:       "www." + zoneName.String(),
+						names.AttrType:       "A",
+						"ttl":                "30",
+						"resource_records.#": acctest.Ct2,
+						"alias_target.#":     acctest.Ct0,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_record_sets.*", map[string]string{
+						names.AttrName:                          "alias." + zoneName.String(),
+						names.AttrType:                          "A",
+						"alias_target.#":                        acctest.Ct1,
+						"alias_target.0.dns_name":               "www." + zoneName.String() + ".",
+						"alias_target.0.evaluate_target_health": acctest.CtFalse,
+						"resource_records.#":                    acctest.Ct0,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_nameRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_nameRegex(zoneName.String(), `^api\.`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", "api."+zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.1.name", "api."+zoneName.String()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_type(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_type(zoneName.String(), "NS"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "NS"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.resource_records.#", acctest.Ct4),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_setIdentifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_setIdentifier(zoneName.String(), "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", "api."+zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.set_identifier", "two"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.weight", "90"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 30
+  records = ["127.0.0.1", "127.0.0.27"]
+}
+
+resource "aws_route53_record" "weighted" {
+  count = 2
+
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "api"
+  type           = "CNAME"
+  ttl            = 60
+  records        = ["backend-${count.index}.example.com"]
+  set_identifier = count.index == 0 ? "one" : "two"
+
+  weighted_routing_policy {
+    weight = count.index == 0 ? 10 : 90
+  }
+}
+
+resource "aws_route53_record" "alias" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "alias"
+  type    = "A"
+
+  alias {
+    name                   = aws_route53_record.test.fqdn
+    zone_id                = aws_route53_zone.test.zone_id
+    evaluate_target_health = false
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.test, aws_route53_record.weighted, aws_route53_record.alias]
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_nameRegex(zoneName, nameRegex string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), fmt.Sprintf(`
+data "aws_route53_records" "test" {
+  zone_id    = aws_route53_zone.test.zone_id
+  name_regex = %[1]q
+
+  depends_on = [aws_route53_record.test, aws_route53_record.weighted, aws_route53_record.alias]
+}
+`, nameRegex))
+}
+
+func testAccRecordsDataSourceConfig_type(zoneName, recordType string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), fmt.Sprintf(`
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  type    = %[1]q
+
+  depends_on = [aws_route53_record.test, aws_route53_record.weighted, aws_route53_record.alias]
+}
+`, recordType))
+}
+
+func testAccRecordsDataSourceConfig_setIdentifier(zoneName, setIdentifier string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), fmt.Sprintf(`
+data "aws_route53_records" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  set_identifier = %[1]q
+
+  depends_on = [aws_route53_record.test, aws_route53_record.weighted, aws_route53_record.alias]
+}
+`, setIdentifier))
+}
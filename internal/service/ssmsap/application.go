@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssmsap_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &applicationResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type applicationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*applicationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssmsap_application"
+}
+
+func (r *applicationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_registry_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w\d\.-]+$`), "must contain only letters, numbers, underscores (_), periods (.) and hyphens (-)"),
+				},
+			},
+			"application_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"components": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"database_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"discovery_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationDiscoveryStatus](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"instances": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"sap_instance_number": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{2}$`), "must be a two-digit number"),
+				},
+			},
+			"sid": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Z][A-Z0-9]{2}$`), "must be 3 uppercase letters or numbers and start with a letter"),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"credentials": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[applicationCredentialModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"credential_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CredentialType](),
+							Required:   true,
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+						},
+						"secret_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *applicationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := data.ApplicationID.ValueString()
+	input := &ssmsap.RegisterApplicationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.RegisterApplication(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("registering Systems Manager for SAP Application (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) register", id), err.Error())
+
+		return
+	}
+
+	application, err := findApplicationByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, application, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *applicationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	output, err := findApplicationByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API response has a field named 'Id' which is the resource's ID.
+	data.ApplicationID = data.ID
+	data.ApplicationType = fwtypes.StringEnumValue(output.Type)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *applicationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new applicationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Credentials.Equal(old.Credentials) || !new.DatabaseARN.Equal(old.DatabaseARN) {
+		input := &ssmsap.UpdateApplicationSettingsInput{
+			ApplicationId: aws.String(id),
+		}
+
+		if !new.DatabaseARN.Equal(old.DatabaseARN) {
+			input.DatabaseArn = fwflex.StringFromFramework(ctx, new.DatabaseARN)
+		}
+
+		if !new.Credentials.Equal(old.Credentials) {
+			var oldCredentials, newCredentials []awstypes.ApplicationCredential
+			response.Diagnostics.Append(fwflex.Expand(ctx, old.Credentials, &oldCredentials)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.Credentials, &newCredentials)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.CredentialsToAddOrUpdate, input.CredentialsToRemove = applicationCredentialsChanges(oldCredentials, newCredentials)
+		}
+
+		output, err := conn.UpdateApplicationSettings(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Systems Manager for SAP Application (%s) settings", id), err.Error())
+
+			return
+		}
+
+		timeout := r.UpdateTimeout(ctx, new.Timeouts)
+		for _, operationID := range output.OperationIds {
+			if _, err := waitOperationSucceeded(ctx, conn, operationID, timeout); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) settings update", id), err.Error())
+
+				return
+			}
+		}
+
+		application, err := findApplicationByID(ctx, conn, id)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s)", id), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, application, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *applicationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data applicationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SSMSAPClient(ctx)
+
+	_, err := conn.DeregisterApplication(ctx, &ssmsap.DeregisterApplicationInput{
+		ApplicationId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deregistering Systems Manager for SAP Application (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitApplicationDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Systems Manager for SAP Application (%s) deregister", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *applicationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// applicationCredentialsChanges returns the credentials to add or update and the credentials to remove.
+// Credentials are keyed by credential type and database name.
+func applicationCredentialsChanges(old, new []awstypes.ApplicationCredential) ([]awstypes.ApplicationCredential, []awstypes.ApplicationCredential) {
+	key := func(v awstypes.ApplicationCredential) string {
+		return string(v.CredentialType) + "/" + aws.ToString(v.DatabaseName)
+	}
+	equal := func(v1, v2 awstypes.ApplicationCredential) bool {
+		return key(v1) == key(v2) && aws.ToString(v1.SecretId) == aws.ToString(v2.SecretId)
+	}
+
+	addOrUpdate := tfslices.Filter(new, func(n awstypes.ApplicationCredential) bool {
+		return !tfslices.Any(old, func(o awstypes.ApplicationCredential) bool {
+			return equal(o, n)
+		})
+	})
+	remove := tfslices.Filter(old, func(o awstypes.ApplicationCredential) bool {
+		return !tfslices.Any(new, func(n awstypes.ApplicationCredential) bool {
+			return key(o) == key(n)
+		})
+	})
+
+	return addOrUpdate, remove
+}
+
+func findApplicationByID(ctx context.Context, conn *ssmsap.Client, id string) (*awstypes.Application, error) {
+	input := &ssmsap.GetApplicationInput{
+		ApplicationId: aws.String(id),
+	}
+
+	output, err := conn.GetApplication(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Application == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Application, nil
+}
+
+func findOperationByID(ctx context.Context, conn *ssmsap.Client, id string) (*awstypes.Operation, error) {
+	input := &ssmsap.GetOperationInput{
+		OperationId: aws.String(id),
+	}
+
+	output, err := conn.GetOperation(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Operation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Operation, nil
+}
+
+func statusApplication(ctx context.Context, conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findApplicationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusOperation(ctx context.Context, conn *ssmsap.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findOperationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitApplicationDeleted(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Application, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ApplicationStatusActivated, awstypes.ApplicationStatusDeleting, awstypes.ApplicationStatusStopped, awstypes.ApplicationStatusUnknown),
+		Target:  []string{},
+		Refresh: statusApplication(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Application); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOperationSucceeded(ctx context.Context, conn *ssmsap.Client, id string, timeout time.Duration) (*awstypes.Operation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.OperationStatusInprogress),
+		Target:  enum.Slice(awstypes.OperationStatusSuccess),
+		Refresh: statusOperation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Operation); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type applicationResourceModel struct {
+	AppRegistryARN    types.String                                               `tfsdk:"app_registry_arn"`
+	ApplicationID     types.String                                               `tfsdk:"application_id"`
+	ApplicationType   fwtypes.StringEnum[awstypes.ApplicationType]               `tfsdk:"application_type"`
+	ARN               types.String                                               `tfsdk:"arn"`
+	Components        fwtypes.ListValueOf[types.String]                          `tfsdk:"components"`
+	Credentials       fwtypes.SetNestedObjectValueOf[applicationCredentialModel] `tfsdk:"credentials"`
+	DatabaseARN       fwtypes.ARN                                                `tfsdk:"database_arn"`
+	DiscoveryStatus   fwtypes.StringEnum[awstypes.ApplicationDiscoveryStatus]    `tfsdk:"discovery_status"`
+	ID                types.String                                               `tfsdk:"id"`
+	Instances         fwtypes.SetValueOf[types.String]                           `tfsdk:"instances"`
+	SAPInstanceNumber types.String                                               `tfsdk:"sap_instance_number"`
+	SID               types.String                                               `tfsdk:"sid"`
+	Status            fwtypes.StringEnum[awstypes.ApplicationStatus]             `tfsdk:"status"`
+	Tags              types.Map                                                  `tfsdk:"tags"`
+	TagsAll           types.Map                                                  `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type applicationCredentialModel struct {
+	CredentialType fwtypes.StringEnum[awstypes.CredentialType] `tfsdk:"credential_type"`
+	DatabaseName   types.String                                `tfsdk:"database_name"`
+	SecretID       types.String                                `tfsdk:"secret_id"`
+}
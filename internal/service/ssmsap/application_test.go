@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssmsap "github.com/hashicorp/terraform-provider-aws/internal/service/ssmsap"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Registering an application requires an EC2 instance running SAP HANA with the
+// SSM Agent installed and a Secrets Manager secret holding the database credentials.
+const (
+	envVarInstanceID = "AWS_SSMSAP_INSTANCE_ID"
+	envVarSecretID   = "AWS_SSMSAP_SECRET_ID"
+	envVarSID        = "AWS_SSMSAP_SID"
+)
+
+func TestAccSSMSAPApplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Application
+	resourceName := "aws_ssmsap_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceID := acctest.SkipIfEnvVarNotSet(t, envVarInstanceID)
+	secretID := acctest.SkipIfEnvVarNotSet(t, envVarSecretID)
+	sid := acctest.SkipIfEnvVarNotSet(t, envVarSID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, instanceID, secretID, sid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrApplicationID, rName),
+					resource.TestCheckResourceAttr(resourceName, "application_type", string(awstypes.ApplicationTypeHana)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "credentials.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "discovery_status"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sid", sid),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials", "sap_instance_number", "sid"},
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Application
+	resourceName := "aws_ssmsap_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceID := acctest.SkipIfEnvVarNotSet(t, envVarInstanceID)
+	secretID := acctest.SkipIfEnvVarNotSet(t, envVarSecretID)
+	sid := acctest.SkipIfEnvVarNotSet(t, envVarSID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_basic(rName, instanceID, secretID, sid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssmsap.ResourceApplication, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMSAPApplication_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Application
+	resourceName := "aws_ssmsap_application.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceID := acctest.SkipIfEnvVarNotSet(t, envVarInstanceID)
+	secretID := acctest.SkipIfEnvVarNotSet(t, envVarSecretID)
+	sid := acctest.SkipIfEnvVarNotSet(t, envVarSID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig_tags1(rName, instanceID, secretID, sid, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials", "sap_instance_number", "sid"},
+			},
+			{
+				Config: testAccApplicationConfig_tags2(rName, instanceID, secretID, sid, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccApplicationConfig_tags1(rName, instanceID, secretID, sid, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckApplicationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmsap_application" {
+				continue
+			}
+
+			_, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Systems Manager for SAP Application %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationExists(ctx context.Context, n string, v *awstypes.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+		output, err := tfssmsap.FindApplicationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMSAPClient(ctx)
+
+	input := &ssmsap.ListApplicationsInput{}
+	_, err := conn.ListApplications(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccApplicationConfig_basic(rName, instanceID, secretID, sid string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = "00"
+  sid                 = %[4]q
+
+  credentials {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[3]q
+  }
+}
+`, rName, instanceID, secretID, sid)
+}
+
+func testAccApplicationConfig_tags1(rName, instanceID, secretID, sid, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = "00"
+  sid                 = %[4]q
+
+  credentials {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[3]q
+  }
+
+  tags = {
+    %[5]q = %[6]q
+  }
+}
+`, rName, instanceID, secretID, sid, tagKey1, tagValue1)
+}
+
+func testAccApplicationConfig_tags2(rName, instanceID, secretID, sid, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssmsap_application" "test" {
+  application_id      = %[1]q
+  application_type    = "HANA"
+  instances           = [%[2]q]
+  sap_instance_number = "00"
+  sid                 = %[4]q
+
+  credentials {
+    credential_type = "ADMIN"
+    database_name   = "SYSTEMDB"
+    secret_id       = %[3]q
+  }
+
+  tags = {
+    %[5]q = %[6]q
+    %[7]q = %[8]q
+  }
+}
+`, rName, instanceID, secretID, sid, tagKey1, tagValue1, tagKey2, tagValue2)
+}
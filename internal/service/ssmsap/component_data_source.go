@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Component")
+func newComponentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &componentDataSource{}, nil
+}
+
+type componentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*componentDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ssmsap_component"
+}
+
+func (d *componentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"associated_host": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[associatedHostModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[associatedHostModel](ctx),
+				},
+			},
+			"child_components": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"component_id": schema.StringAttribute{
+				Required: true,
+			},
+			"component_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComponentType](),
+				Computed:   true,
+			},
+			"database_connection": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[databaseConnectionModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[databaseConnectionModel](ctx),
+				},
+			},
+			"databases": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"hdb_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"hosts": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[hostModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[hostModel](ctx),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_updated": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"parent_component": schema.StringAttribute{
+				Computed: true,
+			},
+			"primary_host": schema.StringAttribute{
+				Computed: true,
+			},
+			"resilience": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resilienceModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[resilienceModel](ctx),
+				},
+			},
+			"sap_feature": schema.StringAttribute{
+				Computed: true,
+			},
+			"sap_hostname": schema.StringAttribute{
+				Computed: true,
+			},
+			"sap_kernel_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"sid": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ComponentStatus](),
+				Computed:   true,
+			},
+			"system_number": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *componentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data componentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMSAPClient(ctx)
+
+	applicationID, componentID := data.ApplicationID.ValueString(), data.ComponentID.ValueString()
+	output, err := findComponentByTwoPartKey(ctx, conn, applicationID, componentID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s) Component (%s)", applicationID, componentID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Component, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(componentID)
+	data.Tags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findComponentByTwoPartKey(ctx context.Context, conn *ssmsap.Client, applicationID, componentID string) (*ssmsap.GetComponentOutput, error) {
+	input := &ssmsap.GetComponentInput{
+		ApplicationId: aws.String(applicationID),
+		ComponentId:   aws.String(componentID),
+	}
+
+	output, err := conn.GetComponent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Component == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type componentDataSourceModel struct {
+	ApplicationID      types.String                                             `tfsdk:"application_id"`
+	ARN                types.String                                             `tfsdk:"arn"`
+	AssociatedHost     fwtypes.ListNestedObjectValueOf[associatedHostModel]     `tfsdk:"associated_host"`
+	ChildComponents    fwtypes.ListValueOf[types.String]                        `tfsdk:"child_components"`
+	ComponentID        types.String                                             `tfsdk:"component_id"`
+	ComponentType      fwtypes.StringEnum[awstypes.ComponentType]               `tfsdk:"component_type"`
+	DatabaseConnection fwtypes.ListNestedObjectValueOf[databaseConnectionModel] `tfsdk:"database_connection"`
+	Databases          fwtypes.ListValueOf[types.String]                        `tfsdk:"databases"`
+	HDBVersion         types.String                                             `tfsdk:"hdb_version"`
+	Hosts              fwtypes.ListNestedObjectValueOf[hostModel]               `tfsdk:"hosts"`
+	ID                 types.String                                             `tfsdk:"id"`
+	LastUpdated        timetypes.RFC3339                                        `tfsdk:"last_updated"`
+	ParentComponent    types.String                                             `tfsdk:"parent_component"`
+	PrimaryHost        types.String                                             `tfsdk:"primary_host"`
+	Resilience         fwtypes.ListNestedObjectValueOf[resilienceModel]         `tfsdk:"resilience"`
+	SAPFeature         types.String                                             `tfsdk:"sap_feature"`
+	SAPHostname        types.String                                             `tfsdk:"sap_hostname"`
+	SAPKernelVersion   types.String                                             `tfsdk:"sap_kernel_version"`
+	SID                types.String                                             `tfsdk:"sid"`
+	Status             fwtypes.StringEnum[awstypes.ComponentStatus]             `tfsdk:"status"`
+	SystemNumber       types.String                                             `tfsdk:"system_number"`
+	Tags               types.Map                                                `tfsdk:"tags"`
+}
+
+type associatedHostModel struct {
+	EC2InstanceID types.String                                    `tfsdk:"ec2_instance_id"`
+	Hostname      types.String                                    `tfsdk:"hostname"`
+	IPAddresses   fwtypes.ListNestedObjectValueOf[ipAddressModel] `tfsdk:"ip_addresses"`
+	OSVersion     types.String                                    `tfsdk:"os_version"`
+}
+
+type ipAddressModel struct {
+	AllocationType fwtypes.StringEnum[awstypes.AllocationType] `tfsdk:"allocation_type"`
+	IPAddress      types.String                                `tfsdk:"ip_address"`
+	Primary        types.Bool                                  `tfsdk:"primary"`
+}
+
+type databaseConnectionModel struct {
+	ConnectionIP             types.String                                          `tfsdk:"connection_ip"`
+	DatabaseARN              types.String                                          `tfsdk:"database_arn"`
+	DatabaseConnectionMethod fwtypes.StringEnum[awstypes.DatabaseConnectionMethod] `tfsdk:"database_connection_method"`
+}
+
+type hostModel struct {
+	EC2InstanceID types.String                          `tfsdk:"ec2_instance_id"`
+	HostIP        types.String                          `tfsdk:"host_ip"`
+	HostName      types.String                          `tfsdk:"host_name"`
+	HostRole      fwtypes.StringEnum[awstypes.HostRole] `tfsdk:"host_role"`
+	InstanceID    types.String                          `tfsdk:"instance_id"`
+	OSVersion     types.String                          `tfsdk:"os_version"`
+}
+
+type resilienceModel struct {
+	ClusterStatus      fwtypes.StringEnum[awstypes.ClusterStatus]   `tfsdk:"cluster_status"`
+	EnqueueReplication types.Bool                                   `tfsdk:"enqueue_replication"`
+	HSROperationMode   fwtypes.StringEnum[awstypes.OperationMode]   `tfsdk:"hsr_operation_mode"`
+	HSRReplicationMode fwtypes.StringEnum[awstypes.ReplicationMode] `tfsdk:"hsr_replication_mode"`
+	HSRTier            types.String                                 `tfsdk:"hsr_tier"`
+}
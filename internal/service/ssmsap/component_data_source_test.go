@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSAPComponentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssmsap_component.test"
+	componentsDataSourceName := "data.aws_ssmsap_components.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceID := acctest.SkipIfEnvVarNotSet(t, envVarInstanceID)
+	secretID := acctest.SkipIfEnvVarNotSet(t, envVarSecretID)
+	sid := acctest.SkipIfEnvVarNotSet(t, envVarSID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentDataSourceConfig_basic(rName, instanceID, secretID, sid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrApplicationID, rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, componentsDataSourceName, "components.0.arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "component_id", componentsDataSourceName, "components.0.component_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "component_type", componentsDataSourceName, "components.0.component_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccComponentDataSourceConfig_basic(rName, instanceID, secretID, sid string) string {
+	return acctest.ConfigCompose(testAccComponentsDataSourceConfig_basic(rName, instanceID, secretID, sid), `
+data "aws_ssmsap_component" "test" {
+  application_id = aws_ssmsap_application.test.application_id
+  component_id   = data.aws_ssmsap_components.test.components[0].component_id
+}
+`)
+}
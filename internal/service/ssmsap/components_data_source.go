@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmsap"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmsap/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Components")
+func newComponentsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &componentsDataSource{}, nil
+}
+
+type componentsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*componentsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ssmsap_components"
+}
+
+func (d *componentsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+			},
+			"components": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[componentSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[componentSummaryModel](ctx),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *componentsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data componentsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SSMSAPClient(ctx)
+
+	applicationID := data.ApplicationID.ValueString()
+	input := &ssmsap.ListComponentsInput{
+		ApplicationId: aws.String(applicationID),
+	}
+
+	components, err := findComponents(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Systems Manager for SAP Application (%s) Components", applicationID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, components, &data.Components)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(applicationID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findComponents(ctx context.Context, conn *ssmsap.Client, input *ssmsap.ListComponentsInput) ([]awstypes.ComponentSummary, error) {
+	var output []awstypes.ComponentSummary
+
+	pages := ssmsap.NewListComponentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Components...)
+	}
+
+	return output, nil
+}
+
+type componentsDataSourceModel struct {
+	ApplicationID types.String                                           `tfsdk:"application_id"`
+	Components    fwtypes.ListNestedObjectValueOf[componentSummaryModel] `tfsdk:"components"`
+	ID            types.String                                           `tfsdk:"id"`
+}
+
+type componentSummaryModel struct {
+	ApplicationID types.String                               `tfsdk:"application_id"`
+	ARN           types.String                               `tfsdk:"arn"`
+	ComponentID   types.String                               `tfsdk:"component_id"`
+	ComponentType fwtypes.StringEnum[awstypes.ComponentType] `tfsdk:"component_type"`
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmsap_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSAPComponentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssmsap_components.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	instanceID := acctest.SkipIfEnvVarNotSet(t, envVarInstanceID)
+	secretID := acctest.SkipIfEnvVarNotSet(t, envVarSecretID)
+	sid := acctest.SkipIfEnvVarNotSet(t, envVarSID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMSAPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentsDataSourceConfig_basic(rName, instanceID, secretID, sid),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrApplicationID, rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "components.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComponentsDataSourceConfig_basic(rName, instanceID, secretID, sid string) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName, instanceID, secretID, sid), `
+data "aws_ssmsap_components" "test" {
+  application_id = aws_ssmsap_application.test.application_id
+}
+`)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Lens Reviews")
+func newLensReviewsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &lensReviewsDataSource{}, nil
+}
+
+type lensReviewsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*lensReviewsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_lens_reviews"
+}
+
+func (d *lensReviewsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"lens_reviews": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[lensReviewSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[lensReviewSummaryModel](ctx),
+				},
+			},
+			"milestone_number": schema.Int64Attribute{
+				Optional: true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *lensReviewsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data lensReviewsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.ListLensReviewsInput{
+		MilestoneNumber: fwflex.Int32FromFramework(ctx, data.MilestoneNumber),
+		WorkloadId:      aws.String(data.WorkloadID.ValueString()),
+	}
+
+	summaries, err := findLensReviewSummaries(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Well-Architected Tool Lens Reviews", err.Error())
+
+		return
+	}
+
+	lensReviews := make([]lensReviewSummaryModel, len(summaries))
+	for i, v := range summaries {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, v, &lensReviews[i], func(opts *fwflex.AutoFlexOptions) {
+			opts.AddIgnoredField("RiskCounts")
+			opts.AddIgnoredField("PrioritizedRiskCounts")
+		})...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		riskCounts, diags := flattenRiskCounts(ctx, v.RiskCounts)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		lensReviews[i].RiskCounts = riskCounts
+
+		prioritizedRiskCounts, diags := flattenRiskCounts(ctx, v.PrioritizedRiskCounts)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		lensReviews[i].PrioritizedRiskCounts = prioritizedRiskCounts
+	}
+
+	lensReviewsValue, diags := fwtypes.NewListNestedObjectValueOfValueSlice(ctx, lensReviews)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.WorkloadID.ValueString())
+	data.LensReviews = lensReviewsValue
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findLensReviewSummaries(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensReviewsInput) ([]awstypes.LensReviewSummary, error) {
+	var output []awstypes.LensReviewSummary
+
+	pages := wellarchitected.NewListLensReviewsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.LensReviewSummaries...)
+	}
+
+	return output, nil
+}
+
+type lensReviewsDataSourceModel struct {
+	ID              types.String                                            `tfsdk:"id"`
+	LensReviews     fwtypes.ListNestedObjectValueOf[lensReviewSummaryModel] `tfsdk:"lens_reviews"`
+	MilestoneNumber types.Int64                                             `tfsdk:"milestone_number"`
+	WorkloadID      types.String                                            `tfsdk:"workload_id"`
+}
+
+type lensReviewSummaryModel struct {
+	LensAlias             types.String                            `tfsdk:"lens_alias"`
+	LensARN               types.String                            `tfsdk:"lens_arn"`
+	LensName              types.String                            `tfsdk:"lens_name"`
+	LensStatus            fwtypes.StringEnum[awstypes.LensStatus] `tfsdk:"lens_status"`
+	LensVersion           types.String                            `tfsdk:"lens_version"`
+	PrioritizedRiskCounts fwtypes.MapValueOf[types.Int64]         `tfsdk:"prioritized_risk_counts"`
+	RiskCounts            fwtypes.MapValueOf[types.Int64]         `tfsdk:"risk_counts"`
+	UpdatedAt             timetypes.RFC3339                       `tfsdk:"updated_at"`
+}
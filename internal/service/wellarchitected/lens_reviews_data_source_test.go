@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedLensReviewsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_wellarchitected_lens_reviews.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensReviewsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lens_reviews.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "lens_reviews.0.lens_alias", "wellarchitected"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lens_reviews.0.lens_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "lens_reviews.0.lens_status", "CURRENT"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lens_reviews.0.risk_counts.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lens_reviews.0.risk_counts.UNANSWERED"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workload_id", "aws_wellarchitected_workload.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccLensReviewsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkloadConfig_basic(rName), `
+data "aws_wellarchitected_lens_reviews" "test" {
+  workload_id = aws_wellarchitected_workload.test.id
+}
+`)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens_share", name="Lens Share")
+func newLensShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &lensShareResource{}, nil
+}
+
+type lensShareResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (*lensShareResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_lens_share"
+}
+
+func (r *lensShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"share_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(12, 2048),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *lensShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.CreateLensShareInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(errs.Must(uuid.GenerateUUID()))
+
+	output, err := conn.CreateLensShare(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Tool Lens Share (%s)", data.LensAlias.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ShareID = fwflex.StringToFramework(ctx, output.ShareId)
+	data.setID()
+
+	share, err := findLensShareByTwoPartKey(ctx, conn, data.LensAlias.ValueString(), data.ShareID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(share.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	share, err := findLensShareByTwoPartKey(ctx, conn, data.LensAlias.ValueString(), data.ShareID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, share, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteLensShare(ctx, &wellarchitected.DeleteLensShareInput{
+		ClientRequestToken: aws.String(errs.Must(uuid.GenerateUUID())),
+		LensAlias:          aws.String(data.LensAlias.ValueString()),
+		ShareId:            aws.String(data.ShareID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Tool Lens Share (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensAlias, shareID string) (*awstypes.LensShareSummary, error) {
+	input := &wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensAlias),
+	}
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if aws.ToString(v.ShareId) == shareID {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+type lensShareResourceModel struct {
+	ID         types.String                             `tfsdk:"id"`
+	LensAlias  types.String                             `tfsdk:"lens_alias"`
+	ShareID    types.String                             `tfsdk:"share_id"`
+	SharedWith types.String                             `tfsdk:"shared_with"`
+	Status     fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+}
+
+const (
+	lensShareResourceIDPartCount = 2
+)
+
+func (data *lensShareResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), lensShareResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.LensAlias = types.StringValue(parts[0])
+	data.ShareID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *lensShareResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.LensAlias.ValueString(), data.ShareID.ValueString()}, lensShareResourceIDPartCount, false)))
+}
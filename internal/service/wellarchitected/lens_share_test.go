@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Lens shares can only be created for published custom lenses, which cannot be managed by Terraform.
+const envVarLensARN = "WELLARCHITECTED_LENS_ARN"
+
+func TestAccWellArchitectedLensShare_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LensShareSummary
+	resourceName := "aws_wellarchitected_lens_share.test"
+	lensARN := acctest.SkipIfEnvVarNotSet(t, envVarLensARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLensShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensShareConfig_basic(lensARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensShareExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lens_alias", lensARN),
+					resource.TestCheckResourceAttrSet(resourceName, "share_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_with", "data.aws_caller_identity.receiver", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLensShare_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LensShareSummary
+	resourceName := "aws_wellarchitected_lens_share.test"
+	lensARN := acctest.SkipIfEnvVarNotSet(t, envVarLensARN)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLensShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensShareConfig_basic(lensARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensShareExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceLensShare, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLensShareDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens_share" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["share_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Tool Lens Share %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLensShareExists(ctx context.Context, n string, v *awstypes.LensShareSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindLensShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["share_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLensShareConfig_basic(lensARN string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "receiver" {
+  provider = "awsalternate"
+}
+
+resource "aws_wellarchitected_lens_share" "test" {
+  lens_alias  = %[1]q
+  shared_with = data.aws_caller_identity.receiver.account_id
+}
+`, lensARN))
+}
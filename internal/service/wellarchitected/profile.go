@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_profile", name="Profile")
+// @Tags(identifierAttribute="arn")
+func newProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileResource{}, nil
+}
+
+type profileResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*profileResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_profile"
+}
+
+func (r *profileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_description": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"profile_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"profile_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"profile_question": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[profileQuestionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"question_id": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"selected_choice_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.CreateProfileInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Tool Profile (%s)", data.ProfileName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ProfileARN = fwflex.StringToFramework(ctx, output.ProfileArn)
+	data.setID()
+
+	profile, err := findProfileByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Owner = fwflex.StringToFramework(ctx, profile.Owner)
+	data.ProfileVersion = fwflex.StringToFramework(ctx, profile.ProfileVersion)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *profileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	profile, err := findProfileByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only questions with selected choices are configured.
+	profile.ProfileQuestions = tfslices.Filter(profile.ProfileQuestions, func(v awstypes.ProfileQuestion) bool {
+		return len(v.SelectedChoiceIds) > 0
+	})
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, profile, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new profileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	if !new.ProfileDescription.Equal(old.ProfileDescription) ||
+		!new.ProfileQuestions.Equal(old.ProfileQuestions) {
+		input := &wellarchitected.UpdateProfileInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Clear the selections of any questions that are no longer configured.
+		for _, questionID := range old.questionIDs(ctx).Difference(new.questionIDs(ctx)) {
+			input.ProfileQuestions = append(input.ProfileQuestions, awstypes.ProfileQuestionUpdate{
+				QuestionId:        aws.String(questionID),
+				SelectedChoiceIds: []string{},
+			})
+		}
+
+		output, err := conn.UpdateProfile(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Tool Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.ProfileVersion = fwflex.StringToFramework(ctx, output.Profile.ProfileVersion)
+	} else {
+		new.ProfileVersion = old.ProfileVersion
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteProfile(ctx, &wellarchitected.DeleteProfileInput{
+		ClientRequestToken: aws.String(errs.Must(uuid.GenerateUUID())),
+		ProfileArn:         aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Tool Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *profileResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findProfileByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Profile, error) {
+	input := &wellarchitected.GetProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+
+	output, err := conn.GetProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Profile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Profile, nil
+}
+
+type profileResourceModel struct {
+	ID                 types.String                                         `tfsdk:"id"`
+	Owner              types.String                                         `tfsdk:"owner"`
+	ProfileARN         types.String                                         `tfsdk:"arn"`
+	ProfileDescription types.String                                         `tfsdk:"profile_description"`
+	ProfileName        types.String                                         `tfsdk:"profile_name"`
+	ProfileQuestions   fwtypes.SetNestedObjectValueOf[profileQuestionModel] `tfsdk:"profile_question"`
+	ProfileVersion     types.String                                         `tfsdk:"profile_version"`
+	Tags               types.Map                                            `tfsdk:"tags"`
+	TagsAll            types.Map                                            `tfsdk:"tags_all"`
+}
+
+func (model *profileResourceModel) InitFromID() error {
+	model.ProfileARN = model.ID
+
+	return nil
+}
+
+func (model *profileResourceModel) setID() {
+	model.ID = model.ProfileARN
+}
+
+func (model *profileResourceModel) questionIDs(ctx context.Context) itypes.Set[string] {
+	questions, _ := model.ProfileQuestions.ToSlice(ctx)
+
+	return tfslices.ApplyToAll(questions, func(v *profileQuestionModel) string {
+		return v.QuestionID.ValueString()
+	})
+}
+
+type profileQuestionModel struct {
+	QuestionID        types.String                     `tfsdk:"question_id"`
+	SelectedChoiceIDs fwtypes.SetValueOf[types.String] `tfsdk:"selected_choice_ids"`
+}
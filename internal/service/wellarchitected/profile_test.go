@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	resourceName := "aws_wellarchitected_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "test profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`profile/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "profile_description", "test profile"),
+					resource.TestCheckResourceAttr(resourceName, "profile_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "profile_question.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "profile_version"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	resourceName := "aws_wellarchitected_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "test profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	resourceName := "aws_wellarchitected_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_basic(rName, "test profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "profile_question.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccProfileConfig_question(rName, "updated test profile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "profile_description", "updated test profile"),
+					resource.TestCheckResourceAttr(resourceName, "profile_question.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "profile_question.*", map[string]string{
+						"question_id":           "workload-criticality",
+						"selected_choice_ids.#": acctest.Ct1,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedProfile_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Profile
+	resourceName := "aws_wellarchitected_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccProfileConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_profile" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindProfileByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Tool Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProfileExists(ctx context.Context, n string, v *awstypes.Profile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindProfileByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfileConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccProfileConfig_question(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = %[2]q
+
+  profile_question {
+    question_id         = "workload-criticality"
+    selected_choice_ids = ["workload_criticality_high"]
+  }
+}
+`, rName, description)
+}
+
+func testAccProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = "test profile"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccProfileConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = "test profile"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
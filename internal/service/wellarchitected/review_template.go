@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_review_template", name="Review Template")
+// @Tags(identifierAttribute="arn")
+func newReviewTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &reviewTemplateResource{}, nil
+}
+
+type reviewTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*reviewTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_review_template"
+}
+
+func (r *reviewTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"template_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *reviewTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.CreateReviewTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateReviewTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Tool Review Template (%s)", data.TemplateName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TemplateARN = fwflex.StringToFramework(ctx, output.TemplateArn)
+	data.setID()
+
+	reviewTemplate, err := findReviewTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Review Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Owner = fwflex.StringToFramework(ctx, reviewTemplate.Owner)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *reviewTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	reviewTemplate, err := findReviewTemplateByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Tool Review Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, reviewTemplate, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *reviewTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new reviewTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.Lenses.Equal(old.Lenses) ||
+		!new.Notes.Equal(old.Notes) ||
+		!new.TemplateName.Equal(old.TemplateName) {
+		input := &wellarchitected.UpdateReviewTemplateInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		if !new.Lenses.Equal(old.Lenses) {
+			os, ns := fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses)
+			input.LensesToAssociate = ns.Difference(os)
+			input.LensesToDisassociate = os.Difference(ns)
+		}
+
+		_, err := conn.UpdateReviewTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Tool Review Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *reviewTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteReviewTemplate(ctx, &wellarchitected.DeleteReviewTemplateInput{
+		ClientRequestToken: aws.String(errs.Must(uuid.GenerateUUID())),
+		TemplateArn:        aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Tool Review Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *reviewTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findReviewTemplateByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.ReviewTemplate, error) {
+	input := &wellarchitected.GetReviewTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+
+	output, err := conn.GetReviewTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReviewTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReviewTemplate, nil
+}
+
+type reviewTemplateResourceModel struct {
+	Description  types.String                     `tfsdk:"description"`
+	ID           types.String                     `tfsdk:"id"`
+	Lenses       fwtypes.SetValueOf[types.String] `tfsdk:"lenses"`
+	Notes        types.String                     `tfsdk:"notes"`
+	Owner        types.String                     `tfsdk:"owner"`
+	Tags         types.Map                        `tfsdk:"tags"`
+	TagsAll      types.Map                        `tfsdk:"tags_all"`
+	TemplateARN  types.String                     `tfsdk:"arn"`
+	TemplateName types.String                     `tfsdk:"template_name"`
+}
+
+func (model *reviewTemplateResourceModel) InitFromID() error {
+	model.TemplateARN = model.ID
+
+	return nil
+}
+
+func (model *reviewTemplateResourceModel) setID() {
+	model.ID = model.TemplateARN
+}
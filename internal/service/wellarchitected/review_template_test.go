@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedReviewTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReviewTemplate
+	resourceName := "aws_wellarchitected_review_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReviewTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReviewTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`review-template/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test review template"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+					resource.TestCheckNoResourceAttr(resourceName, "notes"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedReviewTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReviewTemplate
+	resourceName := "aws_wellarchitected_review_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReviewTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReviewTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceReviewTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedReviewTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReviewTemplate
+	resourceName := "aws_wellarchitected_review_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReviewTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReviewTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccReviewTemplateConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated test review template"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "serverless"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "some notes"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", rName+"-updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedReviewTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ReviewTemplate
+	resourceName := "aws_wellarchitected_review_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReviewTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReviewTemplateConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReviewTemplateConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccReviewTemplateConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReviewTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReviewTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_review_template" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindReviewTemplateByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Tool Review Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckReviewTemplateExists(ctx context.Context, n string, v *awstypes.ReviewTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindReviewTemplateByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReviewTemplateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_review_template" "test" {
+  template_name = %[1]q
+  description   = "test review template"
+  lenses        = ["wellarchitected"]
+}
+`, rName)
+}
+
+func testAccReviewTemplateConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_review_template" "test" {
+  template_name = "%[1]s-updated"
+  description   = "updated test review template"
+  lenses        = ["serverless"]
+  notes         = "some notes"
+}
+`, rName)
+}
+
+func testAccReviewTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_review_template" "test" {
+  template_name = %[1]q
+  description   = "test review template"
+  lenses        = ["wellarchitected"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccReviewTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_wellarchitected_review_template" "test" {
+  template_name = %[1]q
+  description   = "test review template"
+  lenses        = ["wellarchitected"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	resourceName := "aws_wellarchitected_workload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "wellarchitected", regexache.MustCompile(`workload/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test workload"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnvironment, "PREPRODUCTION"),
+					resource.TestCheckResourceAttr(resourceName, "aws_regions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "wellarchitected"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "profile_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	resourceName := "aws_wellarchitected_workload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceWorkload, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	resourceName := "aws_wellarchitected_workload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aws_regions.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated test workload"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnvironment, "PRODUCTION"),
+					resource.TestCheckResourceAttr(resourceName, "lenses.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "lenses.*", "serverless"),
+					resource.TestCheckResourceAttr(resourceName, "notes", "some notes"),
+					resource.TestCheckResourceAttr(resourceName, "profile_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "profile_arns.*", "aws_wellarchitected_profile.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "review_owner", "owner@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedWorkload_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Workload
+	resourceName := "aws_wellarchitected_workload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkloadConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccWorkloadConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_workload" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Tool Workload %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadExists(ctx context.Context, n string, v *awstypes.Workload) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindWorkloadByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+	input := &wellarchitected.ListWorkloadsInput{}
+	_, err := conn.ListWorkloads(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccWorkloadConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "test workload"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  lenses        = ["wellarchitected"]
+}
+`, rName)
+}
+
+func testAccWorkloadConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_profile" "test" {
+  profile_name        = %[1]q
+  profile_description = "test profile"
+}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "updated test workload"
+  environment   = "PRODUCTION"
+  account_ids   = [data.aws_caller_identity.current.account_id]
+  aws_regions   = [data.aws_region.current.name, "us-east-1"]
+  lenses        = ["wellarchitected", "serverless"]
+  notes         = "some notes"
+  profile_arns  = [aws_wellarchitected_profile.test.arn]
+  review_owner  = "owner@example.com"
+}
+`, rName)
+}
+
+func testAccWorkloadConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "test workload"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  lenses        = ["wellarchitected"]
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccWorkloadConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_wellarchitected_workload" "test" {
+  workload_name = %[1]q
+  description   = "test workload"
+  environment   = "PREPRODUCTION"
+  aws_regions   = [data.aws_region.current.name]
+  lenses        = ["wellarchitected"]
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
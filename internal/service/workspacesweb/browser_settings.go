@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_browser_settings", name="Browser Settings")
+// @Tags(identifierAttribute="arn")
+func newBrowserSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &browserSettingsResource{}, nil
+}
+
+type browserSettingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*browserSettingsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_browser_settings"
+}
+
+func (r *browserSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": additionalEncryptionContextAttribute(),
+			names.AttrARN:                   framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns":        associatedPortalARNsAttribute(),
+			"browser_policy": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 131072),
+				},
+			},
+			"customer_managed_key": customerManagedKeyAttribute(),
+			names.AttrID:           framework.IDAttribute(),
+			names.AttrTags:         tftags.TagsAttribute(),
+			names.AttrTagsAll:      tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *browserSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data browserSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateBrowserSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateBrowserSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Browser Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.BrowserSettingsARN = fwflex.StringToFramework(ctx, output.BrowserSettingsArn)
+	data.setID()
+
+	browserSettings, err := findBrowserSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Browser Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, browserSettings.AssociatedPortalArns)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *browserSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data browserSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findBrowserSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Browser Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *browserSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new browserSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.BrowserPolicy.Equal(old.BrowserPolicy) {
+		input := &workspacesweb.UpdateBrowserSettingsInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+
+		_, err := conn.UpdateBrowserSettings(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Browser Settings (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *browserSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data browserSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteBrowserSettings(ctx, &workspacesweb.DeleteBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Browser Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *browserSettingsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findBrowserSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.BrowserSettings, error) {
+	input := &workspacesweb.GetBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetBrowserSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BrowserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BrowserSettings, nil
+}
+
+type browserSettingsResourceModel struct {
+	AdditionalEncryptionContext fwtypes.MapValueOf[types.String]  `tfsdk:"additional_encryption_context"`
+	AssociatedPortalARNs        fwtypes.ListValueOf[types.String] `tfsdk:"associated_portal_arns"`
+	BrowserPolicy               jsontypes.Normalized              `tfsdk:"browser_policy"`
+	BrowserSettingsARN          types.String                      `tfsdk:"arn"`
+	CustomerManagedKey          fwtypes.ARN                       `tfsdk:"customer_managed_key"`
+	ID                          types.String                      `tfsdk:"id"`
+	Tags                        types.Map                         `tfsdk:"tags"`
+	TagsAll                     types.Map                         `tfsdk:"tags_all"`
+}
+
+func (model *browserSettingsResourceModel) InitFromID() error {
+	model.BrowserSettingsARN = model.ID
+
+	return nil
+}
+
+func (model *browserSettingsResourceModel) setID() {
+	model.ID = model.BrowserSettingsARN
+}
+
+// additionalEncryptionContextAttribute returns the schema for the additional encryption context of a settings object or portal.
+func additionalEncryptionContextAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		CustomType:  fwtypes.MapOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// associatedPortalARNsAttribute returns the schema for the ARNs of the portals a settings object is associated with.
+func associatedPortalARNsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// customerManagedKeyAttribute returns the schema for the customer managed KMS key of a settings object or portal.
+func customerManagedKeyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.ARNType,
+		Optional:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_browser_settings_association", name="Browser Settings Association")
+func newBrowserSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &browserSettingsAssociationResource{}, nil
+}
+
+type browserSettingsAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithImportByID
+}
+
+func (*browserSettingsAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_browser_settings_association"
+}
+
+func (r *browserSettingsAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"browser_settings_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *browserSettingsAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data browserSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.AssociateBrowserSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.AssociateBrowserSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Web Browser Settings Association (%s/%s)", data.BrowserSettingsARN.ValueString(), data.PortalARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *browserSettingsAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data browserSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := findBrowserSettingsAssociationByTwoPartKey(ctx, conn, data.BrowserSettingsARN.ValueString(), data.PortalARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Browser Settings Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *browserSettingsAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data browserSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DisassociateBrowserSettings(ctx, &workspacesweb.DisassociateBrowserSettingsInput{
+		PortalArn: aws.String(data.PortalARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Browser Settings Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findBrowserSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, browserSettingsARN, portalARN string) (*awstypes.BrowserSettings, error) {
+	output, err := findBrowserSettingsByARN(ctx, conn, browserSettingsARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(output.AssociatedPortalArns, portalARN) {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+type browserSettingsAssociationResourceModel struct {
+	BrowserSettingsARN fwtypes.ARN  `tfsdk:"browser_settings_arn"`
+	ID                 types.String `tfsdk:"id"`
+	PortalARN          fwtypes.ARN  `tfsdk:"portal_arn"`
+}
+
+const (
+	browserSettingsAssociationResourceIDPartCount = 2
+)
+
+func (data *browserSettingsAssociationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), browserSettingsAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.BrowserSettingsARN = fwtypes.ARNValue(parts[0])
+	data.PortalARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (data *browserSettingsAssociationResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.BrowserSettingsARN.ValueString(), data.PortalARN.ValueString()}, browserSettingsAssociationResourceIDPartCount, false)))
+}
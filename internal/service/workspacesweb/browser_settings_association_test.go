@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebBrowserSettingsAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_browser_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsAssociationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrowserSettingsAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "browser_settings_arn", "aws_workspacesweb_browser_settings.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettingsAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_browser_settings_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsAssociationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrowserSettingsAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceBrowserSettingsAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBrowserSettingsAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_browser_settings_association" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindBrowserSettingsAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["browser_settings_arn"], rs.Primary.Attributes["portal_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Browser Settings Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBrowserSettingsAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		_, err := tfworkspacesweb.FindBrowserSettingsAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["browser_settings_arn"], rs.Primary.Attributes["portal_arn"])
+
+		return err
+	}
+}
+
+func testAccBrowserSettingsAssociationConfig_basic() string {
+	return acctest.ConfigCompose(testAccBrowserSettingsConfig_basic("https://example.com"), `
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_browser_settings_association" "test" {
+  browser_settings_arn = aws_workspacesweb_browser_settings.test.arn
+  portal_arn           = aws_workspacesweb_portal.test.arn
+}
+`)
+}
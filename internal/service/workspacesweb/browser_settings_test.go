@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebBrowserSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic("https://example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrowserSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "browser_policy"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic("https://example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrowserSettingsExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceBrowserSettings, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebBrowserSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.BrowserSettings
+	resourceName := "aws_workspacesweb_browser_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBrowserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBrowserSettingsConfig_basic("https://example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrowserSettingsExists(ctx, resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "browser_policy", regexache.MustCompile(`https://example.com`)),
+				),
+			},
+			{
+				Config: testAccBrowserSettingsConfig_basic("https://example.org"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBrowserSettingsExists(ctx, resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "browser_policy", regexache.MustCompile(`https://example.org`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrowserSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_browser_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindBrowserSettingsByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Browser Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBrowserSettingsExists(ctx context.Context, n string, v *awstypes.BrowserSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindBrowserSettingsByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccBrowserSettingsConfig_basic(startupURL string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_browser_settings" "test" {
+  browser_policy = jsonencode({
+    chromePolicies = {
+      DefaultDownloadDirectory = {
+        value = "/home/as2-streaming-user/MyFiles/TemporaryFiles1"
+      }
+      RestoreOnStartup = {
+        value = 4
+      }
+      RestoreOnStartupURLs = {
+        value = [%[1]q]
+      }
+    }
+  })
+}
+`, startupURL)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+// Exports for use in tests only.
+var (
+	ResourceBrowserSettings                      = newBrowserSettingsResource
+	ResourceBrowserSettingsAssociation           = newBrowserSettingsAssociationResource
+	ResourceIdentityProvider                     = newIdentityProviderResource
+	ResourceIPAccessSettings                     = newIPAccessSettingsResource
+	ResourceIPAccessSettingsAssociation          = newIPAccessSettingsAssociationResource
+	ResourceNetworkSettings                      = newNetworkSettingsResource
+	ResourceNetworkSettingsAssociation           = newNetworkSettingsAssociationResource
+	ResourcePortal                               = newPortalResource
+	ResourceTrustStore                           = newTrustStoreResource
+	ResourceTrustStoreAssociation                = newTrustStoreAssociationResource
+	ResourceUserAccessLoggingSettings            = newUserAccessLoggingSettingsResource
+	ResourceUserAccessLoggingSettingsAssociation = newUserAccessLoggingSettingsAssociationResource
+	ResourceUserSettings                         = newUserSettingsResource
+	ResourceUserSettingsAssociation              = newUserSettingsAssociationResource
+
+	FindBrowserSettingsAssociationByTwoPartKey           = findBrowserSettingsAssociationByTwoPartKey
+	FindBrowserSettingsByARN                             = findBrowserSettingsByARN
+	FindIdentityProviderByARN                            = findIdentityProviderByARN
+	FindIPAccessSettingsAssociationByTwoPartKey          = findIPAccessSettingsAssociationByTwoPartKey
+	FindIPAccessSettingsByARN                            = findIPAccessSettingsByARN
+	FindNetworkSettingsAssociationByTwoPartKey           = findNetworkSettingsAssociationByTwoPartKey
+	FindNetworkSettingsByARN                             = findNetworkSettingsByARN
+	FindPortalByARN                                      = findPortalByARN
+	FindTrustStoreAssociationByTwoPartKey                = findTrustStoreAssociationByTwoPartKey
+	FindTrustStoreByARN                                  = findTrustStoreByARN
+	FindUserAccessLoggingSettingsAssociationByTwoPartKey = findUserAccessLoggingSettingsAssociationByTwoPartKey
+	FindUserAccessLoggingSettingsByARN                   = findUserAccessLoggingSettingsByARN
+	FindUserSettingsAssociationByTwoPartKey              = findUserSettingsAssociationByTwoPartKey
+	FindUserSettingsByARN                                = findUserSettingsByARN
+)
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebIdentityProvider_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IdentityProvider
+	resourceName := "aws_workspacesweb_identity_provider.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_type", "SAML"),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"identity_provider_details"},
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIdentityProvider_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IdentityProvider
+	resourceName := "aws_workspacesweb_identity_provider.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceIdentityProvider, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIdentityProvider_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IdentityProvider
+	resourceName := "aws_workspacesweb_identity_provider.test"
+	rName := sdkacctest.RandString(10)
+	rNameUpdated := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_name", rName),
+				),
+			},
+			{
+				Config: testAccIdentityProviderConfig_basic(rNameUpdated, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "identity_provider_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityProviderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_identity_provider" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindIdentityProviderByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Identity Provider %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIdentityProviderExists(ctx context.Context, n string, v *awstypes.IdentityProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindIdentityProviderByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIdentityProviderConfig_basic(rName, entityID string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_identity_provider" "test" {
+  identity_provider_name = %[1]q
+  identity_provider_type = "SAML"
+  portal_arn             = aws_workspacesweb_portal.test.arn
+
+  identity_provider_details = {
+    MetadataFile = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = %[2]q })
+  }
+}
+`, rName, entityID)
+}
@@ -0,0 +1,305 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_ip_access_settings", name="IP Access Settings")
+// @Tags(identifierAttribute="arn")
+func newIPAccessSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &ipAccessSettingsResource{}, nil
+}
+
+type ipAccessSettingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*ipAccessSettingsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_ip_access_settings"
+}
+
+func (r *ipAccessSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": additionalEncryptionContextAttribute(),
+			names.AttrARN:                   framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns":        associatedPortalARNsAttribute(),
+			names.AttrCreationDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_managed_key": customerManagedKeyAttribute(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"ip_rule": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ipRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, 100),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 256),
+							},
+						},
+						"ip_range": schema.StringAttribute{
+							CustomType: fwtypes.CIDRBlockType,
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ipAccessSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ipAccessSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateIpAccessSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateIpAccessSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web IP Access Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.IPAccessSettingsARN = fwflex.StringToFramework(ctx, output.IpAccessSettingsArn)
+	data.setID()
+
+	ipAccessSettings, err := findIPAccessSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web IP Access Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, ipAccessSettings.AssociatedPortalArns)
+	data.CreationDate = timetypes.NewRFC3339TimePointerValue(ipAccessSettings.CreationDate)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ipAccessSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ipAccessSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findIPAccessSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web IP Access Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ipAccessSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ipAccessSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.IPRules.Equal(old.IPRules) {
+		input := &workspacesweb.UpdateIpAccessSettingsInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+
+		_, err := conn.UpdateIpAccessSettings(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web IP Access Settings (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ipAccessSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ipAccessSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteIpAccessSettings(ctx, &workspacesweb.DeleteIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web IP Access Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ipAccessSettingsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIPAccessSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.IpAccessSettings, error) {
+	input := &workspacesweb.GetIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetIpAccessSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IpAccessSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IpAccessSettings, nil
+}
+
+type ipAccessSettingsResourceModel struct {
+	AdditionalEncryptionContext fwtypes.MapValueOf[types.String]             `tfsdk:"additional_encryption_context"`
+	AssociatedPortalARNs        fwtypes.ListValueOf[types.String]            `tfsdk:"associated_portal_arns"`
+	CreationDate                timetypes.RFC3339                            `tfsdk:"creation_date"`
+	CustomerManagedKey          fwtypes.ARN                                  `tfsdk:"customer_managed_key"`
+	Description                 types.String                                 `tfsdk:"description"`
+	DisplayName                 types.String                                 `tfsdk:"display_name"`
+	ID                          types.String                                 `tfsdk:"id"`
+	IPAccessSettingsARN         types.String                                 `tfsdk:"arn"`
+	IPRules                     fwtypes.ListNestedObjectValueOf[ipRuleModel] `tfsdk:"ip_rule"`
+	Tags                        types.Map                                    `tfsdk:"tags"`
+	TagsAll                     types.Map                                    `tfsdk:"tags_all"`
+}
+
+func (model *ipAccessSettingsResourceModel) InitFromID() error {
+	model.IPAccessSettingsARN = model.ID
+
+	return nil
+}
+
+func (model *ipAccessSettingsResourceModel) setID() {
+	model.ID = model.IPAccessSettingsARN
+}
+
+type ipRuleModel struct {
+	Description types.String      `tfsdk:"description"`
+	IPRange     fwtypes.CIDRBlock `tfsdk:"ip_range"`
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebIPAccessSettingsAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_ip_access_settings_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAccessSettingsAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_access_settings_arn", "aws_workspacesweb_ip_access_settings.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIPAccessSettingsAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_ip_access_settings_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAccessSettingsAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceIPAccessSettingsAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIPAccessSettingsAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_ip_access_settings_association" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindIPAccessSettingsAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["ip_access_settings_arn"], rs.Primary.Attributes["portal_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web IP Access Settings Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPAccessSettingsAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		_, err := tfworkspacesweb.FindIPAccessSettingsAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["ip_access_settings_arn"], rs.Primary.Attributes["portal_arn"])
+
+		return err
+	}
+}
+
+func testAccIPAccessSettingsAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccIPAccessSettingsConfig_basic(rName), `
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_ip_access_settings_association" "test" {
+  ip_access_settings_arn = aws_workspacesweb_ip_access_settings.test.arn
+  portal_arn             = aws_workspacesweb_portal.test.arn
+}
+`)
+}
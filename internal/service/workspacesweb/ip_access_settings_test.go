@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebIPAccessSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IpAccessSettings
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.0.ip_range", "10.0.0.0/16"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIPAccessSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IpAccessSettings
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceIPAccessSettings, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebIPAccessSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.IpAccessSettings
+	resourceName := "aws_workspacesweb_ip_access_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAccessSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAccessSettingsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccIPAccessSettingsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPAccessSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.0.description", "office"),
+					resource.TestCheckResourceAttr(resourceName, "ip_rule.1.ip_range", "192.168.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPAccessSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_ip_access_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindIPAccessSettingsByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web IP Access Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPAccessSettingsExists(ctx context.Context, n string, v *awstypes.IpAccessSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindIPAccessSettingsByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccIPAccessSettingsConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  display_name = %[1]q
+
+  ip_rule {
+    ip_range = "10.0.0.0/16"
+  }
+}
+`, rName)
+}
+
+func testAccIPAccessSettingsConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_ip_access_settings" "test" {
+  description  = "updated"
+  display_name = %[1]q
+
+  ip_rule {
+    description = "office"
+    ip_range    = "10.0.0.0/16"
+  }
+
+  ip_rule {
+    ip_range = "192.168.0.0/24"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_network_settings", name="Network Settings")
+// @Tags(identifierAttribute="arn")
+func newNetworkSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &networkSettingsResource{}, nil
+}
+
+type networkSettingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*networkSettingsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_network_settings"
+}
+
+func (r *networkSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:            framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns": associatedPortalARNsAttribute(),
+			names.AttrID:             framework.IDAttribute(),
+			names.AttrSecurityGroupIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 5),
+				},
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(2, 3),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *networkSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data networkSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateNetworkSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateNetworkSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Network Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.NetworkSettingsARN = fwflex.StringToFramework(ctx, output.NetworkSettingsArn)
+	data.setID()
+
+	networkSettings, err := findNetworkSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Network Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, networkSettings.AssociatedPortalArns)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *networkSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data networkSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findNetworkSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Network Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *networkSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new networkSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.SecurityGroupIDs.Equal(old.SecurityGroupIDs) ||
+		!new.SubnetIDs.Equal(old.SubnetIDs) ||
+		!new.VPCID.Equal(old.VPCID) {
+		input := &workspacesweb.UpdateNetworkSettingsInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+
+		_, err := conn.UpdateNetworkSettings(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Network Settings (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *networkSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data networkSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteNetworkSettings(ctx, &workspacesweb.DeleteNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Network Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *networkSettingsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findNetworkSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.NetworkSettings, error) {
+	input := &workspacesweb.GetNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetNetworkSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NetworkSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NetworkSettings, nil
+}
+
+type networkSettingsResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListValueOf[types.String] `tfsdk:"associated_portal_arns"`
+	ID                   types.String                      `tfsdk:"id"`
+	NetworkSettingsARN   types.String                      `tfsdk:"arn"`
+	SecurityGroupIDs     fwtypes.SetValueOf[types.String]  `tfsdk:"security_group_ids"`
+	SubnetIDs            fwtypes.SetValueOf[types.String]  `tfsdk:"subnet_ids"`
+	Tags                 types.Map                         `tfsdk:"tags"`
+	TagsAll              types.Map                         `tfsdk:"tags_all"`
+	VPCID                types.String                      `tfsdk:"vpc_id"`
+}
+
+func (model *networkSettingsResourceModel) InitFromID() error {
+	model.NetworkSettingsARN = model.ID
+
+	return nil
+}
+
+func (model *networkSettingsResourceModel) setID() {
+	model.ID = model.NetworkSettingsARN
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebNetworkSettingsAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_network_settings_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkSettingsAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_settings_arn", "aws_workspacesweb_network_settings.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebNetworkSettingsAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_network_settings_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkSettingsAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceNetworkSettingsAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkSettingsAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_network_settings_association" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindNetworkSettingsAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["network_settings_arn"], rs.Primary.Attributes["portal_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Network Settings Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkSettingsAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		_, err := tfworkspacesweb.FindNetworkSettingsAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["network_settings_arn"], rs.Primary.Attributes["portal_arn"])
+
+		return err
+	}
+}
+
+func testAccNetworkSettingsAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNetworkSettingsConfig_basic(rName, 0), `
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_network_settings_association" "test" {
+  network_settings_arn = aws_workspacesweb_network_settings.test.arn
+  portal_arn           = aws_workspacesweb_portal.test.arn
+}
+`)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebNetworkSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.NetworkSettings
+	resourceName := "aws_workspacesweb_network_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_ids.0", "aws_security_group.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, "aws_vpc.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebNetworkSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.NetworkSettings
+	resourceName := "aws_workspacesweb_network_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkSettingsExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceNetworkSettings, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebNetworkSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.NetworkSettings
+	resourceName := "aws_workspacesweb_network_settings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_ids.0", "aws_security_group.test.0", names.AttrID),
+				),
+			},
+			{
+				Config: testAccNetworkSettingsConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNetworkSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_ids.0", "aws_security_group.test.1", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_network_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindNetworkSettingsByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Network Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkSettingsExists(ctx context.Context, n string, v *awstypes.NetworkSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindNetworkSettingsByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNetworkSettingsConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  count = 2
+
+  name   = "%[1]s-${count.index}"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccNetworkSettingsConfig_basic(rName string, securityGroupIndex int) string {
+	return acctest.ConfigCompose(testAccNetworkSettingsConfig_base(rName), fmt.Sprintf(`
+resource "aws_workspacesweb_network_settings" "test" {
+  security_group_ids = [aws_security_group.test[%[1]d].id]
+  subnet_ids         = aws_subnet.test[*].id
+  vpc_id             = aws_vpc.test.id
+}
+`, securityGroupIndex))
+}
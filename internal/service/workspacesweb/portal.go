@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_portal", name="Portal")
+// @Tags(identifierAttribute="arn")
+func newPortalResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &portalResource{}, nil
+}
+
+type portalResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*portalResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_portal"
+}
+
+func (r *portalResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": additionalEncryptionContextAttribute(),
+			names.AttrARN:                   framework.ARNAttributeComputedOnly(),
+			"authentication_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"browser_settings_arn": portalAssociatedARNAttribute(),
+			"browser_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BrowserType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreationDate: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_managed_key": customerManagedKeyAttribute(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrInstanceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InstanceType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_access_settings_arn": portalAssociatedARNAttribute(),
+			"max_concurrent_sessions": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5000),
+				},
+			},
+			"network_settings_arn": portalAssociatedARNAttribute(),
+			"portal_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"portal_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PortalStatus](),
+				Computed:   true,
+			},
+			"renderer_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RendererType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatusReason: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:                     tftags.TagsAttribute(),
+			names.AttrTagsAll:                  tftags.TagsAttributeComputedOnly(),
+			"trust_store_arn":                  portalAssociatedARNAttribute(),
+			"user_access_logging_settings_arn": portalAssociatedARNAttribute(),
+			"user_settings_arn":                portalAssociatedARNAttribute(),
+		},
+	}
+}
+
+func (r *portalResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data portalResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreatePortalInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePortal(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Portal", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PortalARN = fwflex.StringToFramework(ctx, output.PortalArn)
+	data.setID()
+
+	portal, err := findPortalByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Portal (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, portal, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *portalResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data portalResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findPortalByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Portal (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *portalResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new portalResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.AuthenticationType.Equal(old.AuthenticationType) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.InstanceType.Equal(old.InstanceType) ||
+		!new.MaxConcurrentSessions.Equal(old.MaxConcurrentSessions) {
+		input := &workspacesweb.UpdatePortalInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdatePortal(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Portal (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Portal, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.PortalStatus = old.PortalStatus
+		new.StatusReason = old.StatusReason
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *portalResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data portalResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeletePortal(ctx, &workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Portal (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *portalResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPortalByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.Portal, error) {
+	input := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPortal(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Portal, nil
+}
+
+type portalResourceModel struct {
+	AdditionalEncryptionContext  fwtypes.MapValueOf[types.String]                `tfsdk:"additional_encryption_context"`
+	AuthenticationType           fwtypes.StringEnum[awstypes.AuthenticationType] `tfsdk:"authentication_type"`
+	BrowserSettingsARN           types.String                                    `tfsdk:"browser_settings_arn"`
+	BrowserType                  fwtypes.StringEnum[awstypes.BrowserType]        `tfsdk:"browser_type"`
+	CreationDate                 timetypes.RFC3339                               `tfsdk:"creation_date"`
+	CustomerManagedKey           fwtypes.ARN                                     `tfsdk:"customer_managed_key"`
+	DisplayName                  types.String                                    `tfsdk:"display_name"`
+	ID                           types.String                                    `tfsdk:"id"`
+	InstanceType                 fwtypes.StringEnum[awstypes.InstanceType]       `tfsdk:"instance_type"`
+	IPAccessSettingsARN          types.String                                    `tfsdk:"ip_access_settings_arn"`
+	MaxConcurrentSessions        types.Int64                                     `tfsdk:"max_concurrent_sessions"`
+	NetworkSettingsARN           types.String                                    `tfsdk:"network_settings_arn"`
+	PortalARN                    types.String                                    `tfsdk:"arn"`
+	PortalEndpoint               types.String                                    `tfsdk:"portal_endpoint"`
+	PortalStatus                 fwtypes.StringEnum[awstypes.PortalStatus]       `tfsdk:"portal_status"`
+	RendererType                 fwtypes.StringEnum[awstypes.RendererType]       `tfsdk:"renderer_type"`
+	StatusReason                 types.String                                    `tfsdk:"status_reason"`
+	Tags                         types.Map                                       `tfsdk:"tags"`
+	TagsAll                      types.Map                                       `tfsdk:"tags_all"`
+	TrustStoreARN                types.String                                    `tfsdk:"trust_store_arn"`
+	UserAccessLoggingSettingsARN types.String                                    `tfsdk:"user_access_logging_settings_arn"`
+	UserSettingsARN              types.String                                    `tfsdk:"user_settings_arn"`
+}
+
+func (model *portalResourceModel) InitFromID() error {
+	model.PortalARN = model.ID
+
+	return nil
+}
+
+func (model *portalResourceModel) setID() {
+	model.ID = model.PortalARN
+}
+
+// portalAssociatedARNAttribute returns the schema for the ARN of a settings object associated with a portal.
+func portalAssociatedARNAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
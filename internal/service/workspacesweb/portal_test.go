@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebPortal_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Portal
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "workspaces-web", regexache.MustCompile(`portal/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "authentication_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "browser_type", "Chrome"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDisplayName),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "portal_status", "Incomplete"),
+					resource.TestCheckResourceAttr(resourceName, "renderer_type", "AppStream"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Portal
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourcePortal, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Portal
+	resourceName := "aws_workspacesweb_portal.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_updated(rName, "standard.regular", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "standard.regular"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_updated(rNameUpdated, "standard.large", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "standard.large"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrent_sessions", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebPortal_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Portal
+	resourceName := "aws_workspacesweb_portal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPortalDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortalConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPortalConfig_tags2(acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPortalConfig_tags1(acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPortalExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPortalDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_portal" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindPortalByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Portal %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPortalExists(ctx context.Context, n string, v *awstypes.Portal) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindPortalByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.ListPortalsInput{}
+	_, err := conn.ListPortals(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+const testAccPortalConfig_basic = `
+resource "aws_workspacesweb_portal" "test" {}
+`
+
+func testAccPortalConfig_updated(rName, instanceType string, maxConcurrentSessions int) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name            = %[1]q
+  instance_type           = %[2]q
+  max_concurrent_sessions = %[3]d
+}
+`, rName, instanceType, maxConcurrentSessions)
+}
+
+func testAccPortalConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccPortalConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
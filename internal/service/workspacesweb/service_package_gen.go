@@ -19,7 +19,88 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newBrowserSettingsAssociationResource,
+			Name:    "Browser Settings Association",
+		},
+		{
+			Factory: newBrowserSettingsResource,
+			Name:    "Browser Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newIPAccessSettingsAssociationResource,
+			Name:    "IP Access Settings Association",
+		},
+		{
+			Factory: newIPAccessSettingsResource,
+			Name:    "IP Access Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newIdentityProviderResource,
+			Name:    "Identity Provider",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newNetworkSettingsAssociationResource,
+			Name:    "Network Settings Association",
+		},
+		{
+			Factory: newNetworkSettingsResource,
+			Name:    "Network Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newPortalResource,
+			Name:    "Portal",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newTrustStoreAssociationResource,
+			Name:    "Trust Store Association",
+		},
+		{
+			Factory: newTrustStoreResource,
+			Name:    "Trust Store",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newUserAccessLoggingSettingsAssociationResource,
+			Name:    "User Access Logging Settings Association",
+		},
+		{
+			Factory: newUserAccessLoggingSettingsResource,
+			Name:    "User Access Logging Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newUserSettingsAssociationResource,
+			Name:    "User Settings Association",
+		},
+		{
+			Factory: newUserSettingsResource,
+			Name:    "User Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_trust_store", name="Trust Store")
+// @Tags(identifierAttribute="arn")
+func newTrustStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &trustStoreResource{}, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*trustStoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_trust_store"
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:            framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns": associatedPortalARNsAttribute(),
+			names.AttrID:             framework.IDAttribute(),
+			names.AttrTags:           tftags.TagsAttribute(),
+			names.AttrTagsAll:        tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrCertificate: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[certificateModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Required: true,
+						},
+						"issuer": schema.StringAttribute{
+							Computed: true,
+						},
+						"not_valid_after": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"not_valid_before": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"subject": schema.StringAttribute{
+							Computed: true,
+						},
+						"thumbprint": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	certificates, diags := data.Certificates.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandCertificateBodies(certificates),
+		ClientToken:     aws.String(errs.Must(uuid.GenerateUUID())),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TrustStoreARN = fwflex.StringToFramework(ctx, output.TrustStoreArn)
+	data.setID()
+
+	response.Diagnostics.Append(r.read(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.read(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.Certificates.Equal(old.Certificates) {
+		oldCertificates, diags := old.Certificates.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newCertificates, diags := new.Certificates.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldBodies := make(map[string]*certificateModel)
+		for _, v := range oldCertificates {
+			oldBodies[v.Body.ValueString()] = v
+		}
+		newBodies := make(map[string]*certificateModel)
+		for _, v := range newCertificates {
+			newBodies[v.Body.ValueString()] = v
+		}
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			ClientToken:   aws.String(errs.Must(uuid.GenerateUUID())),
+			TrustStoreArn: aws.String(new.ID.ValueString()),
+		}
+
+		for body := range newBodies {
+			if _, ok := oldBodies[body]; !ok {
+				input.CertificatesToAdd = append(input.CertificatesToAdd, []byte(body))
+			}
+		}
+		for body, v := range oldBodies {
+			if _, ok := newBodies[body]; !ok {
+				input.CertificatesToDelete = append(input.CertificatesToDelete, v.Thumbprint.ValueString())
+			}
+		}
+
+		_, err := conn.UpdateTrustStore(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(r.read(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteTrustStore(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// read sets the trust store's associated portals and certificates into the specified model.
+func (r *trustStoreResource) read(ctx context.Context, conn *workspacesweb.Client, data *trustStoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, trustStore.AssociatedPortalArns)
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	var certificateModels []*certificateModel
+	for _, v := range certificates {
+		certificateModels = append(certificateModels, &certificateModel{
+			Body:           types.StringValue(string(v.Body)),
+			Issuer:         fwflex.StringToFramework(ctx, v.Issuer),
+			NotValidAfter:  timetypes.NewRFC3339TimePointerValue(v.NotValidAfter),
+			NotValidBefore: timetypes.NewRFC3339TimePointerValue(v.NotValidBefore),
+			Subject:        fwflex.StringToFramework(ctx, v.Subject),
+			Thumbprint:     fwflex.StringToFramework(ctx, v.Thumbprint),
+		})
+	}
+
+	certificatesValue, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, certificateModels)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Certificates = certificatesValue
+
+	return diags
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.Client, arn string) ([]awstypes.Certificate, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []awstypes.Certificate
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CertificateList {
+			certificate, err := findTrustStoreCertificateByTwoPartKey(ctx, conn, arn, aws.ToString(v.Thumbprint))
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *certificate)
+		}
+	}
+
+	return output, nil
+}
+
+func findTrustStoreCertificateByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, arn, thumbprint string) (*awstypes.Certificate, error) {
+	input := &workspacesweb.GetTrustStoreCertificateInput{
+		Thumbprint:    aws.String(thumbprint),
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreCertificate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Certificate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificate, nil
+}
+
+func expandCertificateBodies(tfList []*certificateModel) [][]byte {
+	var apiObjects [][]byte
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, []byte(v.Body.ValueString()))
+	}
+
+	return apiObjects
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListValueOf[types.String]                `tfsdk:"associated_portal_arns"`
+	Certificates         fwtypes.SetNestedObjectValueOf[certificateModel] `tfsdk:"certificate"`
+	ID                   types.String                                     `tfsdk:"id"`
+	Tags                 types.Map                                        `tfsdk:"tags"`
+	TagsAll              types.Map                                        `tfsdk:"tags_all"`
+	TrustStoreARN        types.String                                     `tfsdk:"arn"`
+}
+
+func (model *trustStoreResourceModel) InitFromID() error {
+	model.TrustStoreARN = model.ID
+
+	return nil
+}
+
+func (model *trustStoreResourceModel) setID() {
+	model.ID = model.TrustStoreARN
+}
+
+type certificateModel struct {
+	Body           types.String      `tfsdk:"body"`
+	Issuer         types.String      `tfsdk:"issuer"`
+	NotValidAfter  timetypes.RFC3339 `tfsdk:"not_valid_after"`
+	NotValidBefore timetypes.RFC3339 `tfsdk:"not_valid_before"`
+	Subject        types.String      `tfsdk:"subject"`
+	Thumbprint     types.String      `tfsdk:"thumbprint"`
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebTrustStoreAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_trust_store_association.test"
+	certificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreAssociationConfig_basic(certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_store_arn", "aws_workspacesweb_trust_store.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStoreAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_workspacesweb_trust_store_association.test"
+	certificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreAssociationConfig_basic(certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreAssociationExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceTrustStoreAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_trust_store_association" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindTrustStoreAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["trust_store_arn"], rs.Primary.Attributes["portal_arn"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Trust Store Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreAssociationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		_, err := tfworkspacesweb.FindTrustStoreAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["trust_store_arn"], rs.Primary.Attributes["portal_arn"])
+
+		return err
+	}
+}
+
+func testAccTrustStoreAssociationConfig_basic(certificate string) string {
+	return acctest.ConfigCompose(testAccTrustStoreConfig_basic(certificate), `
+resource "aws_workspacesweb_portal" "test" {}
+
+resource "aws_workspacesweb_trust_store_association" "test" {
+  trust_store_arn = aws_workspacesweb_trust_store.test.arn
+  portal_arn      = aws_workspacesweb_portal.test.arn
+}
+`)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebTrustStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "associated_portal_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.issuer"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.not_valid_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.not_valid_before"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.subject"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.0.thumbprint"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceTrustStore, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate1 := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+	certificate2 := acctest.TLSRSAX509SelfSignedCACertificatePEM(t, acctest.TLSRSAPrivateKeyPEM(t, 2048))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccTrustStoreConfig_two(certificate1, certificate2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccTrustStoreConfig_basic(certificate2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_trust_store" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindTrustStoreByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Trust Store %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreExists(ctx context.Context, n string, v *awstypes.TrustStore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindTrustStoreByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTrustStoreConfig_basic(certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate {
+    body = "%[1]s"
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate))
+}
+
+func testAccTrustStoreConfig_two(certificate1, certificate2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate {
+    body = "%[1]s"
+  }
+
+  certificate {
+    body = "%[2]s"
+  }
+}
+`, acctest.TLSPEMEscapeNewlines(certificate1), acctest.TLSPEMEscapeNewlines(certificate2))
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_user_access_logging_settings", name="User Access Logging Settings")
+// @Tags(identifierAttribute="arn")
+func newUserAccessLoggingSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &userAccessLoggingSettingsResource{}, nil
+}
+
+type userAccessLoggingSettingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*userAccessLoggingSettingsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_user_access_logging_settings"
+}
+
+func (r *userAccessLoggingSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:            framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns": associatedPortalARNsAttribute(),
+			names.AttrID:             framework.IDAttribute(),
+			"kinesis_stream_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`:stream/amazon-workspaces-web-`), "must be the ARN of a Kinesis data stream whose name begins with amazon-workspaces-web-"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *userAccessLoggingSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userAccessLoggingSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateUserAccessLoggingSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateUserAccessLoggingSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web User Access Logging Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.UserAccessLoggingSettingsARN = fwflex.StringToFramework(ctx, output.UserAccessLoggingSettingsArn)
+	data.setID()
+
+	userAccessLoggingSettings, err := findUserAccessLoggingSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web User Access Logging Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, userAccessLoggingSettings.AssociatedPortalArns)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *userAccessLoggingSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userAccessLoggingSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findUserAccessLoggingSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web User Access Logging Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userAccessLoggingSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new userAccessLoggingSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.KinesisStreamARN.Equal(old.KinesisStreamARN) {
+		input := &workspacesweb.UpdateUserAccessLoggingSettingsInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+
+		_, err := conn.UpdateUserAccessLoggingSettings(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web User Access Logging Settings (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *userAccessLoggingSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data userAccessLoggingSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteUserAccessLoggingSettings(ctx, &workspacesweb.DeleteUserAccessLoggingSettingsInput{
+		UserAccessLoggingSettingsArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web User Access Logging Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *userAccessLoggingSettingsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findUserAccessLoggingSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.UserAccessLoggingSettings, error) {
+	input := &workspacesweb.GetUserAccessLoggingSettingsInput{
+		UserAccessLoggingSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserAccessLoggingSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserAccessLoggingSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserAccessLoggingSettings, nil
+}
+
+type userAccessLoggingSettingsResourceModel struct {
+	AssociatedPortalARNs         fwtypes.ListValueOf[types.String] `tfsdk:"associated_portal_arns"`
+	ID                           types.String                      `tfsdk:"id"`
+	KinesisStreamARN             fwtypes.ARN                       `tfsdk:"kinesis_stream_arn"`
+	Tags                         types.Map                         `tfsdk:"tags"`
+	TagsAll                      types.Map                         `tfsdk:"tags_all"`
+	UserAccessLoggingSettingsARN types.String                      `tfsdk:"arn"`
+}
+
+func (model *userAccessLoggingSettingsResourceModel) InitFromID() error {
+	model.UserAccessLoggingSettingsARN = model.ID
+
+	return nil
+}
+
+func (model *userAccessLoggingSettingsResourceModel) setID() {
+	model.ID = model.UserAccessLoggingSettingsARN
+}
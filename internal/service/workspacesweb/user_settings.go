@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	uuid "github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_user_settings", name="User Settings")
+// @Tags(identifierAttribute="arn")
+func newUserSettingsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &userSettingsResource{}, nil
+}
+
+type userSettingsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*userSettingsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_user_settings"
+}
+
+func (r *userSettingsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	enabledType := fwtypes.StringEnumType[awstypes.EnabledType]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"additional_encryption_context": additionalEncryptionContextAttribute(),
+			names.AttrARN:                   framework.ARNAttributeComputedOnly(),
+			"associated_portal_arns":        associatedPortalARNsAttribute(),
+			"copy_allowed": schema.StringAttribute{
+				CustomType: enabledType,
+				Required:   true,
+			},
+			"customer_managed_key": customerManagedKeyAttribute(),
+			"deep_link_allowed": schema.StringAttribute{
+				CustomType: enabledType,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disconnect_timeout_in_minutes": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 600),
+				},
+			},
+			"download_allowed": schema.StringAttribute{
+				CustomType: enabledType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"idle_disconnect_timeout_in_minutes": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 60),
+				},
+			},
+			"paste_allowed": schema.StringAttribute{
+				CustomType: enabledType,
+				Required:   true,
+			},
+			"print_allowed": schema.StringAttribute{
+				CustomType: enabledType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"upload_allowed": schema.StringAttribute{
+				CustomType: enabledType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cookie_synchronization_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cookieSynchronizationConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"allowlist": cookieSpecificationBlock(ctx, true),
+						"blocklist": cookieSpecificationBlock(ctx, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func cookieSpecificationBlock(ctx context.Context, required bool) schema.SetNestedBlock {
+	validators := []validator.Set{
+		setvalidator.SizeAtMost(10),
+	}
+	if required {
+		validators = append(validators, setvalidator.IsRequired())
+	}
+
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[cookieSpecificationModel](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrDomain: schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(0, 253),
+					},
+				},
+				names.AttrName: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(0, 4096),
+					},
+				},
+				names.AttrPath: schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(0, 2000),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *userSettingsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.CreateUserSettingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateUserSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web User Settings", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.UserSettingsARN = fwflex.StringToFramework(ctx, output.UserSettingsArn)
+	data.setID()
+
+	userSettings, err := findUserSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web User Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, userSettings.AssociatedPortalArns)
+	data.DeepLinkAllowed = fwtypes.StringEnumValue(userSettings.DeepLinkAllowed)
+	data.DisconnectTimeoutInMinutes = fwflex.Int32ToFramework(ctx, userSettings.DisconnectTimeoutInMinutes)
+	data.IdleDisconnectTimeoutInMinutes = fwflex.Int32ToFramework(ctx, userSettings.IdleDisconnectTimeoutInMinutes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *userSettingsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	output, err := findUserSettingsByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web User Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userSettingsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new userSettingsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.CookieSynchronizationConfiguration.Equal(old.CookieSynchronizationConfiguration) ||
+		!new.CopyAllowed.Equal(old.CopyAllowed) ||
+		!new.DeepLinkAllowed.Equal(old.DeepLinkAllowed) ||
+		!new.DisconnectTimeoutInMinutes.Equal(old.DisconnectTimeoutInMinutes) ||
+		!new.DownloadAllowed.Equal(old.DownloadAllowed) ||
+		!new.IdleDisconnectTimeoutInMinutes.Equal(old.IdleDisconnectTimeoutInMinutes) ||
+		!new.PasteAllowed.Equal(old.PasteAllowed) ||
+		!new.PrintAllowed.Equal(old.PrintAllowed) ||
+		!new.UploadAllowed.Equal(old.UploadAllowed) {
+		input := &workspacesweb.UpdateUserSettingsInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.ClientToken = aws.String(errs.Must(uuid.GenerateUUID()))
+
+		output, err := conn.UpdateUserSettings(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web User Settings (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.DeepLinkAllowed = fwtypes.StringEnumValue(output.UserSettings.DeepLinkAllowed)
+		new.DisconnectTimeoutInMinutes = fwflex.Int32ToFramework(ctx, output.UserSettings.DisconnectTimeoutInMinutes)
+		new.IdleDisconnectTimeoutInMinutes = fwflex.Int32ToFramework(ctx, output.UserSettings.IdleDisconnectTimeoutInMinutes)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *userSettingsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data userSettingsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteUserSettings(ctx, &workspacesweb.DeleteUserSettingsInput{
+		UserSettingsArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web User Settings (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *userSettingsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findUserSettingsByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.UserSettings, error) {
+	input := &workspacesweb.GetUserSettingsInput{
+		UserSettingsArn: aws.String(arn),
+	}
+
+	output, err := conn.GetUserSettings(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserSettings, nil
+}
+
+type userSettingsResourceModel struct {
+	AdditionalEncryptionContext        fwtypes.MapValueOf[types.String]                                         `tfsdk:"additional_encryption_context"`
+	AssociatedPortalARNs               fwtypes.ListValueOf[types.String]                                        `tfsdk:"associated_portal_arns"`
+	CookieSynchronizationConfiguration fwtypes.ListNestedObjectValueOf[cookieSynchronizationConfigurationModel] `tfsdk:"cookie_synchronization_configuration"`
+	CopyAllowed                        fwtypes.StringEnum[awstypes.EnabledType]                                 `tfsdk:"copy_allowed"`
+	CustomerManagedKey                 fwtypes.ARN                                                              `tfsdk:"customer_managed_key"`
+	DeepLinkAllowed                    fwtypes.StringEnum[awstypes.EnabledType]                                 `tfsdk:"deep_link_allowed"`
+	DisconnectTimeoutInMinutes         types.Int64                                                              `tfsdk:"disconnect_timeout_in_minutes"`
+	DownloadAllowed                    fwtypes.StringEnum[awstypes.EnabledType]                                 `tfsdk:"download_allowed"`
+	ID                                 types.String                                                             `tfsdk:"id"`
+	IdleDisconnectTimeoutInMinutes     types.Int64                                                              `tfsdk:"idle_disconnect_timeout_in_minutes"`
+	PasteAllowed                       fwtypes.StringEnum[awstypes.EnabledType]                                 `tfsdk:"paste_allowed"`
+	PrintAllowed                       fwtypes.StringEnum[awstypes.EnabledType]                                 `tfsdk:"print_allowed"`
+	Tags                               types.Map                                                                `tfsdk:"tags"`
+	TagsAll                            types.Map                                                                `tfsdk:"tags_all"`
+	UploadAllowed                      fwtypes.StringEnum[awstypes.EnabledType]                                 `tfsdk:"upload_allowed"`
+	UserSettingsARN                    types.String                                                             `tfsdk:"arn"`
+}
+
+func (model *userSettingsResourceModel) InitFromID() error {
+	model.UserSettingsARN = model.ID
+
+	return nil
+}
+
+func (model *userSettingsResourceModel) setID() {
+	model.ID = model.UserSettingsARN
+}
+
+type cookieSynchronizationConfigurationModel struct {
+	Allowlist fwtypes.SetNestedObjectValueOf[cookieSpecificationModel] `tfsdk:"allowlist"`
+	Blocklist fwtypes.SetNestedObjectValueOf[cookieSpecificationModel] `tfsdk:"blocklist"`
+}
+
+type cookieSpecificationModel struct {
+	Domain types.String `tfsdk:"domain"`
+	Name   types.String `tfsdk:"name"`
+	Path   types.String `tfsdk:"path"`
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebUserSettings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "deep_link_allowed"),
+					resource.TestCheckResourceAttrSet(resourceName, "disconnect_timeout_in_minutes"),
+					resource.TestCheckResourceAttr(resourceName, "download_allowed", "Enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "idle_disconnect_timeout_in_minutes"),
+					resource.TestCheckResourceAttr(resourceName, "paste_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "print_allowed", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "upload_allowed", "Enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceUserSettings, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebUserSettings_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.UserSettings
+	resourceName := "aws_workspacesweb_user_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserSettingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserSettingsConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccUserSettingsConfig_updated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUserSettingsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.0.allowlist.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cookie_synchronization_configuration.0.allowlist.*", map[string]string{
+						names.AttrDomain: "example.com",
+						names.AttrName:   "session",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cookie_synchronization_configuration.0.blocklist.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "copy_allowed", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "disconnect_timeout_in_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "download_allowed", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "idle_disconnect_timeout_in_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "paste_allowed", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "print_allowed", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "upload_allowed", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserSettingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_user_settings" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindUserSettingsByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web User Settings %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserSettingsExists(ctx context.Context, n string, v *awstypes.UserSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindUserSettingsByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+const testAccUserSettingsConfig_basic = `
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed     = "Enabled"
+  download_allowed = "Enabled"
+  paste_allowed    = "Enabled"
+  print_allowed    = "Enabled"
+  upload_allowed   = "Enabled"
+}
+`
+
+const testAccUserSettingsConfig_updated = `
+resource "aws_workspacesweb_user_settings" "test" {
+  copy_allowed                       = "Disabled"
+  disconnect_timeout_in_minutes      = 120
+  download_allowed                   = "Disabled"
+  idle_disconnect_timeout_in_minutes = 30
+  paste_allowed                      = "Disabled"
+  print_allowed                      = "Disabled"
+  upload_allowed                     = "Disabled"
+
+  cookie_synchronization_configuration {
+    allowlist {
+      domain = "example.com"
+      name   = "session"
+    }
+
+    blocklist {
+      domain = "blocked.example.com"
+    }
+  }
+}
+`